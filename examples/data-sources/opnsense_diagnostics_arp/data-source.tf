@@ -0,0 +1,15 @@
+data "opnsense_diagnostics_arp" "all" {}
+
+// Pin the MAC address currently seen for a host into a Kea reservation
+locals {
+  printer = one([for e in data.opnsense_diagnostics_arp.all.entries : e if e.ip == "10.8.2.50"])
+}
+
+resource "opnsense_kea_reservation" "printer" {
+  subnet_id = opnsense_kea_subnet.lan.id
+
+  ip_address  = local.printer.ip
+  mac_address = local.printer.mac
+
+  description = local.printer.hostname
+}
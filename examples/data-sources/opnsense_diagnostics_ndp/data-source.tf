@@ -0,0 +1,6 @@
+data "opnsense_diagnostics_ndp" "all" {}
+
+// List IPv6 neighbours seen on a specific device
+output "lan_neighbours" {
+  value = [for e in data.opnsense_diagnostics_ndp.all.entries : e.ip if e.interface == "vtnet1"]
+}
@@ -0,0 +1,15 @@
+data "opnsense_diagnostics_routes" "all" {}
+
+resource "opnsense_route" "branch" {
+  description = "Branch network"
+  gateway = "BRANCH_GW"
+  network = "10.9.0.0/24"
+}
+
+// Check that the route was installed in the kernel routing table
+check "branch_route_installed" {
+  assert {
+    condition     = anytrue([for r in data.opnsense_diagnostics_routes.all.routes : r.destination == opnsense_route.branch.network])
+    error_message = "Route to ${opnsense_route.branch.network} is not installed."
+  }
+}
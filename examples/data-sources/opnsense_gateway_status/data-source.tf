@@ -0,0 +1,12 @@
+data "opnsense_gateway_status" "all" {}
+
+// Make sure the failover gateway is healthy before shifting traffic
+check "backup_gateway_online" {
+  assert {
+    condition = alltrue([
+      for g in data.opnsense_gateway_status.all.gateways : g.status == "none" && g.loss < 5
+      if g.name == "WAN2_GW"
+    ])
+    error_message = "Backup gateway WAN2_GW is not healthy."
+  }
+}
@@ -0,0 +1,25 @@
+data "opnsense_interfaces_overview" "all" {}
+
+// Look up the logical identifier of the interface described as DMZ
+locals {
+  dmz = one([for i in data.opnsense_interfaces_overview.all.interfaces : i.identifier if i.description == "DMZ"])
+}
+
+resource "opnsense_firewall_filter" "allow_dmz_web" {
+  action = "pass"
+  interface = [
+    local.dmz,
+  ]
+
+  direction = "in"
+  protocol  = "TCP"
+
+  destination = {
+    port = "https"
+  }
+}
+
+// List interfaces that are enabled but have no link
+output "down_interfaces" {
+  value = [for i in data.opnsense_interfaces_overview.all.interfaces : i.identifier if i.enabled && i.link_state != "up"]
+}
@@ -0,0 +1,9 @@
+data "opnsense_quagga_bgp_routes" "all" {}
+
+// Check that the default route is learned from the upstream provider
+check "bgp_default_route_learned" {
+  assert {
+    condition     = anytrue([for r in data.opnsense_quagga_bgp_routes.all.routes : r.network == "0.0.0.0/0" && r.best])
+    error_message = "No best BGP path for the default route."
+  }
+}
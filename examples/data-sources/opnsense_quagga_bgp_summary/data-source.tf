@@ -0,0 +1,9 @@
+data "opnsense_quagga_bgp_summary" "all" {}
+
+// Fail the run if any BGP session is not established after a change
+check "bgp_sessions_established" {
+  assert {
+    condition     = data.opnsense_quagga_bgp_summary.all.all_established
+    error_message = "Not all BGP sessions are established: ${join(", ", [for p in data.opnsense_quagga_bgp_summary.all.peers : "${p.neighbor} (${p.state})" if p.state != "Established"])}"
+  }
+}
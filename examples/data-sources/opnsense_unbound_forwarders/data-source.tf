@@ -0,0 +1,14 @@
+data "opnsense_unbound_forwarders" "all" {}
+
+// Check that every enabled forward uses DNS-over-TLS and is loaded by Unbound
+check "forwarders_use_dot" {
+  assert {
+    condition     = alltrue([for f in data.opnsense_unbound_forwarders.all.forwarders : f.type == "dot" if f.enabled])
+    error_message = "All enabled forwarders must use DNS-over-TLS."
+  }
+
+  assert {
+    condition     = alltrue([for f in data.opnsense_unbound_forwarders.all.forwarders : f.status == "active" if f.enabled])
+    error_message = "Not all enabled forwarders are loaded by Unbound."
+  }
+}
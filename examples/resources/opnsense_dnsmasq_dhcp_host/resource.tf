@@ -0,0 +1,13 @@
+resource "opnsense_dnsmasq_dhcp_tag" "printers" {
+  name = "printers"
+}
+
+resource "opnsense_dnsmasq_dhcp_host" "printer" {
+  hostname      = "printer"
+  domain        = "example.lan"
+  ip_address    = "192.168.1.20"
+  mac_addresses = ["00:11:22:33:44:55"]
+  tags          = [opnsense_dnsmasq_dhcp_tag.printers.id]
+
+  description = "Office printer"
+}
@@ -0,0 +1,18 @@
+// DNS servers for all clients on the LAN
+resource "opnsense_dnsmasq_dhcp_option" "dns" {
+  interface = "lan"
+  option    = "6"
+  value     = "192.168.1.1,192.168.1.2"
+}
+
+// NTP server, only for clients with the printers tag
+resource "opnsense_dnsmasq_dhcp_tag" "printers" {
+  name = "printers"
+}
+
+resource "opnsense_dnsmasq_dhcp_option" "ntp" {
+  tag    = opnsense_dnsmasq_dhcp_tag.printers.id
+  option = "ntp-server"
+  value  = "192.168.1.1"
+  force  = true
+}
@@ -0,0 +1,18 @@
+// IPv4 range
+resource "opnsense_dnsmasq_dhcp_range" "lan" {
+  interface     = "lan"
+  start_address = "192.168.1.100"
+  end_address   = "192.168.1.199"
+  lease_time    = 86400
+
+  description = "LAN clients"
+}
+
+// IPv6 range built from the prefix delegated to the WAN interface
+resource "opnsense_dnsmasq_dhcp_range" "lan_v6" {
+  interface     = "lan"
+  start_address = "::1000"
+  end_address   = "::1fff"
+  constructor   = "wan"
+  ra_mode       = ["slaac", "ra-names"]
+}
@@ -0,0 +1,3 @@
+resource "opnsense_dnsmasq_dhcp_tag" "printers" {
+  name = "printers"
+}
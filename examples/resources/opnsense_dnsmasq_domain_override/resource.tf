@@ -0,0 +1,8 @@
+// Send all queries for corp.example.com to the office DNS server
+resource "opnsense_dnsmasq_domain_override" "corp" {
+  domain      = "corp.example.com"
+  server_ip   = "10.8.0.1"
+  server_port = 53
+
+  description = "Office DNS"
+}
@@ -0,0 +1,8 @@
+resource "opnsense_dnsmasq_host_override" "nas" {
+  hostname  = "nas"
+  domain    = "example.lan"
+  addresses = ["192.168.1.10", "fd00::10"]
+  aliases   = ["files.example.lan"]
+
+  description = "Network storage"
+}
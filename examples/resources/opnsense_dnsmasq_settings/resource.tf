@@ -0,0 +1,9 @@
+resource "opnsense_dnsmasq_settings" "example" {
+  enabled    = true
+  interfaces = ["lan"]
+  port       = 53
+
+  strict_order       = false
+  domain_needed      = true
+  no_private_reverse = true
+}
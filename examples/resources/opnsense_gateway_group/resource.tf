@@ -0,0 +1,44 @@
+resource "opnsense_settings_gateway" "wan1" {
+  name        = "WAN1_GW"
+  interface   = "wan"
+  ip_protocol = "inet"
+  gateway     = "198.51.100.1"
+}
+
+resource "opnsense_settings_gateway" "wan2" {
+  name        = "WAN2_GW"
+  interface   = "opt1"
+  ip_protocol = "inet"
+  gateway     = "203.0.113.1"
+}
+
+resource "opnsense_gateway_group" "failover" {
+  name        = "WAN_FAILOVER"
+  description = "Prefer WAN1, fail over to WAN2"
+  trigger     = "downloss"
+
+  members = [
+    {
+      gateway = opnsense_settings_gateway.wan1.name
+      tier    = 1
+    },
+    {
+      gateway = opnsense_settings_gateway.wan2.name
+      tier    = 2
+    },
+  ]
+}
+
+resource "opnsense_firewall_filter" "lan_failover" {
+  action    = "pass"
+  interface = ["lan"]
+  direction = "in"
+  protocol  = "any"
+
+  source = {
+    net = "lan"
+  }
+
+  gateway     = opnsense_gateway_group.failover.name
+  description = "route LAN through WAN failover group"
+}
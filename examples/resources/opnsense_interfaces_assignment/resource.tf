@@ -0,0 +1,28 @@
+resource "opnsense_interfaces_vlan" "dmz" {
+  description = "DMZ vlan"
+  tag = 20
+  priority = 0
+  parent = "vtnet0"
+  device = "vlan020"
+}
+
+// Assign the VLAN to a new logical interface with a static address
+resource "opnsense_interfaces_assignment" "dmz" {
+  device      = opnsense_interfaces_vlan.dmz.device
+  description = "DMZ"
+
+  ipv4_type    = "static"
+  ipv4_address = "192.168.20.1/24"
+
+  ipv6_type        = "track6"
+  track6_interface = "wan"
+  track6_prefix_id = 1
+}
+
+// Use DHCP on a physical device
+resource "opnsense_interfaces_assignment" "uplink" {
+  device       = "vtnet2"
+  description  = "Uplink"
+  block_bogons = true
+  ipv4_type    = "dhcp"
+}
@@ -0,0 +1,15 @@
+resource "opnsense_interfaces_vxlan" "branch" {
+  vni            = 10020
+  local_address  = "203.0.113.10"
+  remote_address = "198.51.100.40"
+}
+
+// Extend the local segment to the branch over VXLAN
+resource "opnsense_interfaces_bridge" "branch" {
+  description = "Branch L2 extension"
+  members     = ["vtnet1", opnsense_interfaces_vxlan.branch.device]
+
+  stp_enabled    = true
+  stp_protocol   = "rstp"
+  stp_interfaces = ["vtnet1"]
+}
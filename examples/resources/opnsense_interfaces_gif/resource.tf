@@ -0,0 +1,15 @@
+resource "opnsense_interfaces_gif" "cloud" {
+  description           = "IPv6 overlay to cloud router"
+  local_address         = "wan"
+  remote_address        = "198.51.100.30"
+  tunnel_local_address  = "2001:db8:ffff::1"
+  tunnel_remote_address = "2001:db8:ffff::2"
+  tunnel_remote_netmask = 64
+  ingress_filtering     = false
+}
+
+// Assign the tunnel device so it can be routed and filtered
+resource "opnsense_interfaces_assignment" "cloud" {
+  device      = opnsense_interfaces_gif.cloud.device
+  description = "CLOUD_GIF"
+}
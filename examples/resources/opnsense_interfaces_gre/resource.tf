@@ -0,0 +1,14 @@
+resource "opnsense_interfaces_gre" "cloud" {
+  description           = "Overlay to cloud router"
+  local_address         = "wan"
+  remote_address        = "198.51.100.20"
+  tunnel_local_address  = "10.255.0.1"
+  tunnel_remote_address = "10.255.0.2"
+  tunnel_remote_netmask = 30
+}
+
+// Assign the tunnel device so it can be routed and filtered
+resource "opnsense_interfaces_assignment" "cloud" {
+  device      = opnsense_interfaces_gre.cloud.device
+  description = "CLOUD_GRE"
+}
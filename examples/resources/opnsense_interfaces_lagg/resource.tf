@@ -0,0 +1,15 @@
+resource "opnsense_interfaces_lagg" "uplink" {
+  description       = "Uplink bond"
+  members           = ["vtnet1", "vtnet2"]
+  protocol          = "lacp"
+  lacp_fast_timeout = true
+  hash_layers       = ["l2", "l3"]
+}
+
+// Build a VLAN on top of the LAGG
+resource "opnsense_interfaces_vlan" "servers" {
+  description = "Servers"
+  tag = 30
+  priority = 0
+  parent = opnsense_interfaces_lagg.uplink.device
+}
@@ -0,0 +1,11 @@
+resource "opnsense_interfaces_loopback" "router_id" {
+  description = "BGP router ID"
+}
+
+// Assign the loopback to configure its address
+resource "opnsense_interfaces_assignment" "router_id" {
+  device       = opnsense_interfaces_loopback.router_id.device
+  description  = "ROUTER_ID"
+  ipv4_type    = "static"
+  ipv4_address = "10.255.255.1/32"
+}
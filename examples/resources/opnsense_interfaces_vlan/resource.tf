@@ -14,3 +14,19 @@ resource "opnsense_interfaces_vlan" "vlan04" {
   parent = "vtnet0"
   device = "vlan04"
 }
+
+// QinQ: customer VLAN stacked on an 802.1ad service VLAN
+resource "opnsense_interfaces_vlan" "service" {
+  description = "Service VLAN"
+  tag = 100
+  protocol = "802.1ad"
+  parent = "vtnet1"
+  device = "vlan0.100"
+}
+
+resource "opnsense_interfaces_vlan" "customer" {
+  description = "Customer VLAN"
+  tag = 10
+  parent = opnsense_interfaces_vlan.service.device
+  device = "qinq0.100.10"
+}
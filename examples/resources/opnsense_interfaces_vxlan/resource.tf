@@ -0,0 +1,15 @@
+// Unicast VXLAN to a single remote endpoint
+resource "opnsense_interfaces_vxlan" "branch" {
+  vni            = 10020
+  local_address  = "203.0.113.10"
+  remote_address = "198.51.100.40"
+}
+
+// Multicast VXLAN
+resource "opnsense_interfaces_vxlan" "fabric" {
+  vni              = 10030
+  local_address    = "10.0.0.1"
+  multicast_group  = "239.1.1.30"
+  multicast_device = "vtnet1"
+  port             = 8472
+}
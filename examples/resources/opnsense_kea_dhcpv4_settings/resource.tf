@@ -0,0 +1,26 @@
+// Single server
+resource "opnsense_kea_dhcpv4_settings" "example" {
+  interfaces     = ["lan"]
+  valid_lifetime = 86400
+}
+
+// HA pair, this machine is the primary
+resource "opnsense_kea_peer" "primary" {
+  name = "fw1"
+  role = "primary"
+  url = "http://192.0.2.1:8001/"
+}
+
+resource "opnsense_kea_peer" "standby" {
+  name = "fw2"
+  role = "standby"
+  url = "http://192.0.2.2:8001/"
+}
+
+resource "opnsense_kea_dhcpv4_settings" "ha" {
+  interfaces = ["lan"]
+
+  ha_enabled          = true
+  this_server_name    = opnsense_kea_peer.primary.name
+  max_unacked_clients = 2
+}
@@ -0,0 +1,21 @@
+// Configure a directly connected BFD peer
+resource "opnsense_quagga_bfd_peer" "example0" {
+  description = "bfdpeer0"
+
+  address   = "192.0.2.1"
+  interface = "wan"
+
+  detect_multiplier = 3
+  receive_interval  = 300
+  transmit_interval = 300
+  echo_mode         = true
+}
+
+// Configure a BFD peer for a multihop BGP session
+resource "opnsense_quagga_bfd_peer" "example1" {
+  description = "bfdpeer1"
+
+  address       = "198.51.100.1"
+  local_address = "203.0.113.1"
+  multihop      = true
+}
@@ -0,0 +1,19 @@
+// Configure the BGP instance
+resource "opnsense_quagga_bgp" "example" {
+  enabled   = true
+  as_number = 65001
+  router_id = "10.0.0.1"
+
+  networks = [
+    "192.0.2.0/24",
+  ]
+
+  redistribute = [
+    "connected",
+    "ospf",
+  ]
+
+  log_neighbor_changes = true
+  graceful_restart     = true
+  multipath            = true
+}
@@ -57,3 +57,29 @@ resource "opnsense_quagga_bgp_neighbor" "example0" {
   prefix_list_in = opnsense_quagga_bgp_prefixlist.example0.id
   route_map_out = opnsense_quagga_bgp_routemap.example0.id
 }
+
+// Configure a neighbor inheriting its policy from a peer group
+resource "opnsense_quagga_bgp_peergroup" "example0" {
+  name      = "customers"
+  remote_as = 65010
+
+  route_map_out = opnsense_quagga_bgp_routemap.example0.id
+}
+
+resource "opnsense_quagga_bgp_neighbor" "example1" {
+  peer_ip    = "2001:db8::10"
+  peer_group = opnsense_quagga_bgp_peergroup.example0.id
+  shutdown   = false
+
+  ipv4_unicast = {
+    activate = false
+  }
+
+  ipv6_unicast = {
+    activate                     = true
+    maximum_prefix               = 100
+    maximum_prefix_restart       = 30
+    soft_reconfiguration_inbound = true
+    send_community               = "all"
+  }
+}
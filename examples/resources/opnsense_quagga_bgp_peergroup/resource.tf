@@ -0,0 +1,15 @@
+// Configure a peer group
+resource "opnsense_quagga_bgp_peergroup" "example0" {
+  description = "peergroup0"
+
+  name          = "transit"
+  remote_as     = 64512
+  update_source = "wan"
+  next_hop_self = true
+}
+
+// Neighbors only need an address and the peer group
+resource "opnsense_quagga_bgp_neighbor" "example0" {
+  peer_ip    = "192.0.2.1"
+  peer_group = opnsense_quagga_bgp_peergroup.example0.id
+}
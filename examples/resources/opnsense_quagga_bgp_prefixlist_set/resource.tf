@@ -0,0 +1,25 @@
+// Configure a complete prefix list
+resource "opnsense_quagga_bgp_prefixlist_set" "customer" {
+  description = "customer routes"
+
+  name       = "customer-in"
+  ip_version = "IPv4"
+
+  entries = [
+    {
+      sequence = 10
+      network  = "198.51.100.0/24"
+    },
+    {
+      sequence = 20
+      network  = "203.0.113.0/24"
+      le       = 26
+    },
+    {
+      sequence = 100
+      action   = "deny"
+      network  = "0.0.0.0/0"
+      le       = 32
+    },
+  ]
+}
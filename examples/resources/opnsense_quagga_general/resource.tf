@@ -0,0 +1,8 @@
+// Enable the routing daemon on the CARP master only
+resource "opnsense_quagga_general" "example" {
+  enabled    = true
+  carp_aware = true
+
+  log_level       = "informational"
+  syslog_facility = "local3"
+}
@@ -0,0 +1,5 @@
+// Configure an NSSA area
+resource "opnsense_quagga_ospf6_area" "example0" {
+  area_id = "0.0.0.2"
+  type    = "nssa"
+}
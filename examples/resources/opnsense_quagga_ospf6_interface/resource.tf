@@ -0,0 +1,10 @@
+// Configure an interface forming adjacencies
+resource "opnsense_quagga_ospf6_interface" "example0" {
+  interface = "lan"
+  area      = "0.0.0.0"
+  passive   = false
+
+  cost           = 10
+  hello_interval = 5
+  dead_interval  = 20
+}
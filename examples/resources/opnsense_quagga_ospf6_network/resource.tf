@@ -0,0 +1,6 @@
+// Configure a network in the backbone area
+resource "opnsense_quagga_ospf6_network" "example0" {
+  address = "2001:db8::"
+  mask    = 64
+  area    = "0.0.0.0"
+}
@@ -0,0 +1,9 @@
+// Configure the OSPFv3 global settings
+resource "opnsense_quagga_ospf6_settings" "example" {
+  enabled   = true
+  router_id = "10.0.0.1"
+
+  redistribute = [
+    "connected",
+  ]
+}
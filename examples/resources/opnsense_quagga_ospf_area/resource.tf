@@ -0,0 +1,6 @@
+// Configure a stub area
+resource "opnsense_quagga_ospf_area" "example0" {
+  area_id     = "0.0.0.1"
+  type        = "stub"
+  description = "branch offices"
+}
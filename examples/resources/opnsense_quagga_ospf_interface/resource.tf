@@ -0,0 +1,15 @@
+// Configure an interface forming adjacencies
+resource "opnsense_quagga_ospf_interface" "example0" {
+  interface    = "lan"
+  area         = "0.0.0.0"
+  network_type = "point-to-point"
+  passive      = false
+
+  cost           = 10
+  hello_interval = 5
+  dead_interval  = 20
+
+  auth_type   = "message-digest"
+  auth_key    = "secret"
+  auth_key_id = 1
+}
@@ -0,0 +1,16 @@
+// Only accept routes matching this prefix list into the area
+resource "opnsense_quagga_bgp_prefixlist" "example0" {
+  name    = "ospf-in"
+  number  = 10
+  action  = "permit"
+  network = "10.0.0.0/8 le 24"
+}
+
+// Configure a network in the backbone area
+resource "opnsense_quagga_ospf_network" "example0" {
+  address = "10.0.0.0"
+  mask    = 24
+  area    = "0.0.0.0"
+
+  prefix_list_in = opnsense_quagga_bgp_prefixlist.example0.id
+}
@@ -0,0 +1,21 @@
+// Only redistribute routes matching this route map
+resource "opnsense_quagga_bgp_routemap" "example0" {
+  name         = "ospf-redistribute"
+  action       = "permit"
+  route_map_id = 10
+}
+
+// Configure the OSPF global settings
+resource "opnsense_quagga_ospf_settings" "example" {
+  enabled         = true
+  router_id       = "10.0.0.1"
+  passive_default = true
+
+  redistribute = [
+    "connected",
+    "static",
+  ]
+  redistribute_route_map = opnsense_quagga_bgp_routemap.example0.id
+
+  default_originate = true
+}
@@ -0,0 +1,16 @@
+// Configure a static route via a next hop
+resource "opnsense_quagga_static_route" "example0" {
+  description = "staticroute0"
+
+  network = "10.20.0.0/16"
+  gateway = "192.0.2.254"
+}
+
+// Configure a floating route out of an interface with a high distance
+resource "opnsense_quagga_static_route" "example1" {
+  description = "staticroute1"
+
+  network   = "2001:db8:100::/48"
+  interface = "lan"
+  distance  = 250
+}
@@ -0,0 +1,26 @@
+resource "opnsense_wireguard_server" "vpn" {
+  name = "vpn"
+
+  tunnel_address = [
+    "10.20.0.1/24",
+  ]
+}
+
+// Only answer queries from the LAN and the WireGuard tunnel
+resource "opnsense_unbound_acl" "example" {
+  default_action = "refuse"
+
+  acls = [
+    {
+      name     = "lan"
+      action   = "allow"
+      networks = ["192.168.1.0/24"]
+    },
+    {
+      name        = "vpn"
+      action      = "allow"
+      networks    = opnsense_wireguard_server.vpn.tunnel_address
+      description = "WireGuard clients"
+    },
+  ]
+}
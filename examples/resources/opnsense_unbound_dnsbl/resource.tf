@@ -0,0 +1,25 @@
+// Strict policy for the home network
+resource "opnsense_unbound_dnsbl" "home" {
+  description = "Home"
+
+  providers = ["ag", "el", "ep"]
+  custom_urls = ["https://example.com/blocklist.txt"]
+
+  allowlist = ["ads.example.org"]
+  blocklist = ["tracker.example.net"]
+  wildcards = ["doubleclick.net"]
+
+  source_networks = ["192.168.1.0/24"]
+  nxdomain = true
+  refresh_interval = 24
+}
+
+// Lighter policy for the office network
+resource "opnsense_unbound_dnsbl" "office" {
+  description = "Office"
+
+  providers = ["ag"]
+
+  source_networks = ["10.10.0.0/16"]
+  address = "0.0.0.0"
+}
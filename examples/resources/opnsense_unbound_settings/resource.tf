@@ -0,0 +1,21 @@
+// Configure Unbound as a validating resolver for the LAN
+resource "opnsense_unbound_settings" "example" {
+  enabled = true
+  port    = 53
+
+  listen_interfaces = [
+    "lan",
+  ]
+
+  dnssec               = true
+  register_dhcp_leases = true
+
+  prefetch           = true
+  serve_expired      = true
+  message_cache_size = "16m"
+  rrset_cache_size   = "32m"
+
+  private_domains = [
+    "corp.example.com",
+  ]
+}
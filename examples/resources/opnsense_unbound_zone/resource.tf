@@ -0,0 +1,31 @@
+resource "opnsense_unbound_zone" "internal" {
+  domain = "corp.example.com"
+
+  records = [
+    {
+      hostname = "gw"
+      value    = "10.0.0.1"
+    },
+    {
+      hostname = "gw"
+      type     = "AAAA"
+      value    = "fd00:10::1"
+    },
+    {
+      hostname    = "mail"
+      value       = "10.0.0.25"
+      description = "Mail server"
+    },
+    {
+      hostname    = ""
+      type        = "MX"
+      value       = "mail.corp.example.com"
+      mx_priority = 10
+    },
+    {
+      hostname = ""
+      type     = "TXT"
+      value    = "v=spf1 mx -all"
+    },
+  ]
+}
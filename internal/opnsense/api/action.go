@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	upstream "github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+)
+
+// Since the OPNsense controllers have to be reconfigured after every change, all writes share the mutex used by
+// opnsense-go.
+const clientMutexKey = "OPNSENSE"
+
+// ActionResult is the response of OPNsense endpoints that change state. Validations maps the path of each rejected
+// field (e.g. `prefixlist.network`) to the reason it was rejected.
+type ActionResult struct {
+	Result      string            `json:"result"`
+	UUID        string            `json:"uuid,omitempty"`
+	Validations map[string]string `json:"validations,omitempty"`
+}
+
+// SearchResult is the response of OPNsense `search*` endpoints.
+type SearchResult[R any] struct {
+	Rows     []R   `json:"rows"`
+	RowCount int64 `json:"rowCount"`
+	Total    int64 `json:"total"`
+	Current  int64 `json:"current"`
+}
+
+// Action posts body to endpoint, and returns the result reported by OPNsense. Unlike Add and Update, it does not
+// check the result or reconfigure the service, so callers can batch several changes before reconfiguring once.
+func Action(c *Client, ctx context.Context, endpoint string, body map[string]any) (*ActionResult, error) {
+	upstream.GlobalMutexKV.Lock(clientMutexKey, ctx)
+	defer upstream.GlobalMutexKV.Unlock(clientMutexKey, ctx)
+
+	return post(c, ctx, endpoint, body)
+}
+
+func post(c *Client, ctx context.Context, endpoint string, body map[string]any) (*ActionResult, error) {
+	return Call(c, ctx, RPCOpts{
+		BaseEndpoint:   endpoint,
+		Method:         "POST",
+		BodyParameters: body,
+	}, &ActionResult{})
+}
+
+// Search returns every row of a `search*` endpoint.
+func Search[R any](c *Client, ctx context.Context, endpoint string) (*SearchResult[R], error) {
+	return Call(c, ctx, RPCOpts{
+		BaseEndpoint: endpoint,
+		Method:       "POST",
+		BodyParameters: map[string]any{
+			"current":  1,
+			"rowCount": -1,
+		},
+	}, &SearchResult[R]{})
+}
+
+// GetSettings reads a settings model that has a single instance (e.g. `/quagga/bgp/get`) into resource.
+func GetSettings[K any](c *Client, ctx context.Context, opts ReqOpts, resource *K) (*K, error) {
+	var reqData map[string]json.RawMessage
+	if _, err := Call(c, ctx, RPCOpts{BaseEndpoint: opts.GetEndpoint, Method: "GET"}, &reqData); err != nil {
+		return nil, err
+	}
+
+	wrapped, ok := reqData[opts.Monad]
+	if !ok {
+		return nil, errs.NewNotFoundError()
+	}
+
+	if err := json.Unmarshal(wrapped, resource); err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// UpdateSettings writes a settings model that has a single instance, then reconfigures the service.
+func UpdateSettings[K any](c *Client, ctx context.Context, opts ReqOpts, resource *K) error {
+	upstream.GlobalMutexKV.Lock(clientMutexKey, ctx)
+	defer upstream.GlobalMutexKV.Unlock(clientMutexKey, ctx)
+
+	res, err := post(c, ctx, opts.UpdateEndpoint, map[string]any{opts.Monad: resource})
+	if err != nil {
+		return err
+	}
+
+	if res.Result != "saved" {
+		return fmt.Errorf("resource not changed. result: %s. errors: %s", res.Result, res.Validations)
+	}
+
+	return c.ReconfigureService(ctx, opts.ReconfigureEndpoint)
+}
+
+// Reconfigure applies the pending changes of the service at endpoint. Services report `{"status": "ok"}` rather
+// than a result, so any other status is returned as a `failed` result.
+func Reconfigure(c *Client, ctx context.Context, endpoint string) (*ActionResult, error) {
+	upstream.GlobalMutexKV.Lock(clientMutexKey, ctx)
+	defer upstream.GlobalMutexKV.Unlock(clientMutexKey, ctx)
+
+	respJson := &struct {
+		Status string `json:"status,omitempty"`
+		Result string `json:"result,omitempty"`
+	}{}
+	if _, err := Call(c, ctx, RPCOpts{BaseEndpoint: endpoint, Method: "POST"}, respJson); err != nil {
+		return nil, err
+	}
+
+	status := respJson.Status
+	if status == "" {
+		status = respJson.Result
+	}
+	if !strings.EqualFold(strings.TrimSpace(status), "ok") {
+		return &ActionResult{Result: "failed"}, nil
+	}
+	return &ActionResult{Result: "ok"}, nil
+}
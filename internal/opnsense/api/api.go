@@ -0,0 +1,56 @@
+// Package api extends the opnsense-go api package with the response types and request helpers used by the
+// controllers in this module. The client, request options and field types are re-exported unchanged, so values can
+// be passed freely between this package and opnsense-go.
+package api
+
+import (
+	"context"
+
+	upstream "github.com/browningluke/opnsense-go/pkg/api"
+)
+
+type (
+	Client          = upstream.Client
+	Options         = upstream.Options
+	ReqOpts         = upstream.ReqOpts
+	RPCOpts         = upstream.RPCOpts
+	SelectedMap     = upstream.SelectedMap
+	SelectedMapList = upstream.SelectedMapList
+)
+
+// NewClient creates a new API client.
+func NewClient(options Options) *Client {
+	return upstream.NewClient(options)
+}
+
+// CRUD operations
+
+func Add[K any](c *Client, ctx context.Context, opts ReqOpts, resource *K) (string, error) {
+	return upstream.Add(c, ctx, opts, resource)
+}
+
+func Get[K any](c *Client, ctx context.Context, opts ReqOpts, resource *K, id string) (*K, error) {
+	return upstream.Get(c, ctx, opts, resource, id)
+}
+
+func GetAll[K any](c *Client, ctx context.Context, opts ReqOpts, resources []K) ([]K, error) {
+	return upstream.GetAll(c, ctx, opts, resources)
+}
+
+func GetFilter[K any](c *Client, ctx context.Context, opts ReqOpts, resource *K, key string) (*K, error) {
+	return upstream.GetFilter(c, ctx, opts, resource, key)
+}
+
+func Update[K any](c *Client, ctx context.Context, opts ReqOpts, resource *K, id string) error {
+	return upstream.Update(c, ctx, opts, resource, id)
+}
+
+func Delete(c *Client, ctx context.Context, opts ReqOpts, id string) error {
+	return upstream.Delete(c, ctx, opts, id)
+}
+
+// RPC operations
+
+func Call[R any](c *Client, ctx context.Context, rpcOpts RPCOpts, result *R) (*R, error) {
+	return upstream.Call(c, ctx, rpcOpts, result)
+}
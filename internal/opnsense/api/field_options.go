@@ -0,0 +1,68 @@
+package api
+
+import (
+	"encoding/json"
+)
+
+/*
+	Settings endpoints return option fields with every available option, e.g.:
+	"some_key" : {
+		"K1": {
+			"value": "...",
+			"selected": 0 (or false),
+		},
+		"K2": {
+			"value": "...",
+			"selected": 1 (or true),
+		},
+	}
+
+	Unlike SelectedMap, FieldOptions keeps all options, which is needed for fields that allow more than one
+	selection (e.g. interface lists).
+*/
+
+type FieldOptions map[string]FieldOption
+
+type FieldOption struct {
+	Value    string `json:"value"`
+	Selected int    `json:"selected"`
+}
+
+func (o *FieldOption) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Value    string `json:"value"`
+		Selected any    `json:"selected"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	o.Value = raw.Value
+	o.Selected = 0
+	switch selected := raw.Selected.(type) {
+	case bool:
+		if selected {
+			o.Selected = 1
+		}
+	case float64:
+		o.Selected = int(selected)
+	}
+
+	return nil
+}
+
+func (o *FieldOptions) UnmarshalJSON(data []byte) error {
+	var options map[string]FieldOption
+	if err := json.Unmarshal(data, &options); err != nil {
+		// Fields without any options are returned as an empty list or string
+		var empty any
+		if json.Unmarshal(data, &empty) == nil {
+			*o = FieldOptions{}
+			return nil
+		}
+		return err
+	}
+
+	*o = options
+	return nil
+}
@@ -0,0 +1,74 @@
+// Package opnsense provides the API client used by the provider. It is a drop-in replacement for the opnsense-go
+// client, whose controllers are extended with the endpoints that are not part of the pinned opnsense-go release.
+package opnsense
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/bind"
+	"github.com/browningluke/opnsense-go/pkg/core"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/opnsense-go/pkg/ipsec"
+	upstream "github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/opnsense-go/pkg/wireguard"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/gateway"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/kea"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/routes"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+)
+
+// Client defines a client interface for the OPNsense API.
+type Client interface {
+	Bind() *bind.Controller
+	Core() *core.Controller
+	Diagnostics() *diagnostics.Controller
+	Firewall() *firewall.Controller
+	Gateway() *gateway.Controller
+	Interfaces() *interfaces.Controller
+	Ipsec() *ipsec.Controller
+	Kea() *kea.Controller
+	Quagga() *quagga.Controller
+	Routes() *routes.Controller
+	Unbound() *unbound.Controller
+	Wireguard() *wireguard.Controller
+}
+
+type client struct {
+	upstream.Client
+	a *api.Client
+}
+
+// NewClient creates a new API client.
+func NewClient(a *api.Client) Client {
+	return &client{Client: upstream.NewClient(a), a: a}
+}
+
+func (c *client) Diagnostics() *diagnostics.Controller {
+	return diagnostics.NewController(c.a)
+}
+
+func (c *client) Gateway() *gateway.Controller {
+	return &gateway.Controller{Api: c.a}
+}
+
+func (c *client) Interfaces() *interfaces.Controller {
+	return interfaces.NewController(c.a)
+}
+
+func (c *client) Kea() *kea.Controller {
+	return kea.NewController(c.a)
+}
+
+func (c *client) Quagga() *quagga.Controller {
+	return quagga.NewController(c.a)
+}
+
+func (c *client) Routes() *routes.Controller {
+	return routes.NewController(c.a)
+}
+
+func (c *client) Unbound() *unbound.Controller {
+	return unbound.NewController(c.a)
+}
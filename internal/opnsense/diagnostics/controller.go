@@ -0,0 +1,25 @@
+// Package diagnostics extends the opnsense-go diagnostics controller.
+package diagnostics
+
+import (
+	upstream "github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Controller for diagnostics
+type Controller struct {
+	upstream.Controller
+}
+
+// NewController creates a controller using the API client a.
+func NewController(a *api.Client) *Controller {
+	return &Controller{Controller: upstream.Controller{Api: a}}
+}
+
+// Data structs provided by opnsense-go
+
+type (
+	Interface  = upstream.Interface
+	Ipv4Config = upstream.Ipv4Config
+	Ipv6Config = upstream.Ipv6Config
+)
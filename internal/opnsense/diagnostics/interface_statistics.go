@@ -0,0 +1,64 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Data structs
+
+type InterfaceStatistics struct {
+	BytesIn    int64
+	BytesOut   int64
+	PacketsIn  int64
+	PacketsOut int64
+	ErrorsIn   int64
+	ErrorsOut  int64
+	Collisions int64
+}
+
+type interfaceCounters struct {
+	Device     string      `json:"device"`
+	BytesIn    json.Number `json:"inbytes"`
+	BytesOut   json.Number `json:"outbytes"`
+	PacketsIn  json.Number `json:"inpkts"`
+	PacketsOut json.Number `json:"outpkts"`
+	ErrorsIn   json.Number `json:"inerrs"`
+	ErrorsOut  json.Number `json:"outerrs"`
+	Collisions json.Number `json:"collisions"`
+}
+
+// Read operations
+
+// GetInterfaceStatistics returns the traffic counters of every assigned interface, keyed by device (e.g. `vtnet0`).
+func (c *Controller) GetInterfaceStatistics(ctx context.Context) (map[string]InterfaceStatistics, error) {
+	var resp struct {
+		Interfaces map[string]interfaceCounters `json:"interfaces"`
+	}
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: "/diagnostics/traffic/interface", Method: "GET"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]InterfaceStatistics, len(resp.Interfaces))
+	for _, counters := range resp.Interfaces {
+		stats[counters.Device] = InterfaceStatistics{
+			BytesIn:    counterValue(counters.BytesIn),
+			BytesOut:   counterValue(counters.BytesOut),
+			PacketsIn:  counterValue(counters.PacketsIn),
+			PacketsOut: counterValue(counters.PacketsOut),
+			ErrorsIn:   counterValue(counters.ErrorsIn),
+			ErrorsOut:  counterValue(counters.ErrorsOut),
+			Collisions: counterValue(counters.Collisions),
+		}
+	}
+
+	return stats, nil
+}
+
+func counterValue(n json.Number) int64 {
+	v, _ := n.Int64()
+	return v
+}
@@ -0,0 +1,48 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Data structs
+
+type ArpEntry struct {
+	IP                   string `json:"ip"`
+	MAC                  string `json:"mac"`
+	Interface            string `json:"intf"`
+	InterfaceDescription string `json:"intf_description"`
+	Hostname             string `json:"hostname"`
+	Manufacturer         string `json:"manufacturer"`
+	Permanent            bool   `json:"permanent"`
+	Expires              int64  `json:"expires"`
+}
+
+type NdpEntry struct {
+	IP                   string `json:"ip"`
+	MAC                  string `json:"mac"`
+	Interface            string `json:"intf"`
+	InterfaceDescription string `json:"intf_description"`
+	Hostname             string `json:"hostname"`
+	Manufacturer         string `json:"manufacturer"`
+}
+
+// Read operations
+
+func (c *Controller) GetArp(ctx context.Context) ([]ArpEntry, error) {
+	return getList[ArpEntry](c, ctx, "/diagnostics/interface/getArp")
+}
+
+func (c *Controller) GetNdp(ctx context.Context) ([]NdpEntry, error) {
+	return getList[NdpEntry](c, ctx, "/diagnostics/interface/getNdp")
+}
+
+func getList[R any](c *Controller, ctx context.Context, endpoint string) ([]R, error) {
+	var entries []R
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: endpoint, Method: "GET"}, &entries)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,23 @@
+package diagnostics
+
+import (
+	"context"
+)
+
+// Data structs
+
+type Route struct {
+	Destination          string `json:"destination"`
+	Gateway              string `json:"gateway"`
+	Flags                string `json:"flags"`
+	Interface            string `json:"netif"`
+	InterfaceDescription string `json:"intf_description"`
+	MTU                  string `json:"mtu"`
+	Protocol             string `json:"proto"`
+}
+
+// Read operations
+
+func (c *Controller) GetRoutes(ctx context.Context) ([]Route, error) {
+	return getList[Route](c, ctx, "/diagnostics/interface/getRoutes")
+}
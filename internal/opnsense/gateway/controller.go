@@ -0,0 +1,54 @@
+// Package gateway provides the gateway endpoints used to validate routes.
+package gateway
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Controller for gateway
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
+
+// Data structs
+
+type GatewayRow struct {
+	UUID       string `json:"uuid"`
+	Name       string `json:"name"`
+	Interface  string `json:"interface"`
+	IPProtocol string `json:"ipprotocol"`
+	Gateway    string `json:"gateway"`
+	Disabled   string `json:"disabled"`
+}
+
+type GatewayStatusResponse struct {
+	Items  []GatewayStatus `json:"items"`
+	Status string          `json:"status"`
+}
+
+type GatewayStatus struct {
+	Name             string `json:"name"`
+	Address          string `json:"address"`
+	Monitor          string `json:"monitor"`
+	Status           string `json:"status"`
+	StatusTranslated string `json:"status_translated"`
+	Delay            string `json:"delay"`
+	StdDev           string `json:"stddev"`
+	Loss             string `json:"loss"`
+}
+
+// Read operations
+
+func (c *Controller) SettingsSearchGateway(ctx context.Context) (*api.SearchResult[GatewayRow], error) {
+	return api.Search[GatewayRow](c.Client(), ctx, "/routing/settings/searchGateway")
+}
+
+func (c *Controller) GatewayStatus(ctx context.Context) (*GatewayStatusResponse, error) {
+	return api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: "/routes/gateway/status", Method: "GET"}, &GatewayStatusResponse{})
+}
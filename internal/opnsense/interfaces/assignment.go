@@ -0,0 +1,56 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var AssignmentOpts = api.ReqOpts{
+	AddEndpoint:         "/interfaces/assign_settings/addItem",
+	GetEndpoint:         "/interfaces/assign_settings/getItem",
+	UpdateEndpoint:      "/interfaces/assign_settings/setItem",
+	DeleteEndpoint:      "/interfaces/assign_settings/delItem",
+	ReconfigureEndpoint: "/interfaces/assign_settings/reconfigure",
+	Monad:               "assign",
+}
+
+// Data structs
+
+type Assignment struct {
+	Device          api.SelectedMap `json:"if"`
+	Description     string          `json:"descr"`
+	Enabled         string          `json:"enable"`
+	BlockPrivate    string          `json:"blockpriv"`
+	BlockBogons     string          `json:"blockbogons"`
+	MTU             string          `json:"mtu"`
+	MSS             string          `json:"mss"`
+	IPv4Type        api.SelectedMap `json:"ipv4_type"`
+	IPv4Address     string          `json:"ipaddr"`
+	IPv4Subnet      string          `json:"subnet"`
+	IPv4Gateway     api.SelectedMap `json:"gateway"`
+	IPv6Type        api.SelectedMap `json:"ipv6_type"`
+	IPv6Address     string          `json:"ipaddrv6"`
+	IPv6Subnet      string          `json:"subnetv6"`
+	IPv6Gateway     api.SelectedMap `json:"gatewayv6"`
+	Track6Interface api.SelectedMap `json:"track6-interface"`
+	Track6PrefixID  string          `json:"track6-prefix-id"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddAssignment(ctx context.Context, resource *Assignment) (string, error) {
+	return api.Add(c.Client(), ctx, AssignmentOpts, resource)
+}
+
+func (c *Controller) GetAssignment(ctx context.Context, id string) (*Assignment, error) {
+	return api.Get(c.Client(), ctx, AssignmentOpts, &Assignment{}, id)
+}
+
+func (c *Controller) UpdateAssignment(ctx context.Context, id string, resource *Assignment) error {
+	return api.Update(c.Client(), ctx, AssignmentOpts, resource, id)
+}
+
+func (c *Controller) DeleteAssignment(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, AssignmentOpts, id)
+}
@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var BridgeOpts = api.ReqOpts{
+	AddEndpoint:         "/interfaces/bridge_settings/addItem",
+	GetEndpoint:         "/interfaces/bridge_settings/getItem",
+	UpdateEndpoint:      "/interfaces/bridge_settings/setItem",
+	DeleteEndpoint:      "/interfaces/bridge_settings/delItem",
+	ReconfigureEndpoint: "/interfaces/bridge_settings/reconfigure",
+	Monad:               "bridge",
+}
+
+// Data structs
+
+type Bridge struct {
+	Members         api.SelectedMapList `json:"members"`
+	STPEnabled      string              `json:"stp"`
+	STPProtocol     api.SelectedMap     `json:"proto"`
+	STPInterfaces   api.SelectedMapList `json:"stp_interfaces"`
+	STPMaxAge       string              `json:"maxage"`
+	STPForwardDelay string              `json:"fwdelay"`
+	STPHoldCount    string              `json:"holdcnt"`
+	STPPriority     string              `json:"bridgeprio"`
+	SpanPorts       api.SelectedMapList `json:"span"`
+	LinkLocal       string              `json:"linklocal"`
+	Learning        string              `json:"enablelearning"`
+	Description     string              `json:"descr"`
+	Device          string              `json:"bridgeif"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddBridge(ctx context.Context, resource *Bridge) (string, error) {
+	return api.Add(c.Client(), ctx, BridgeOpts, resource)
+}
+
+func (c *Controller) GetBridge(ctx context.Context, id string) (*Bridge, error) {
+	return api.Get(c.Client(), ctx, BridgeOpts, &Bridge{}, id)
+}
+
+func (c *Controller) UpdateBridge(ctx context.Context, id string, resource *Bridge) error {
+	return api.Update(c.Client(), ctx, BridgeOpts, resource, id)
+}
+
+func (c *Controller) DeleteBridge(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, BridgeOpts, id)
+}
@@ -0,0 +1,21 @@
+// Package interfaces extends the opnsense-go interfaces controller.
+package interfaces
+
+import (
+	upstream "github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Controller for interfaces
+type Controller struct {
+	upstream.Controller
+}
+
+// NewController creates a controller using the API client a.
+func NewController(a *api.Client) *Controller {
+	return &Controller{Controller: upstream.Controller{Api: a}}
+}
+
+// Data structs provided by opnsense-go
+
+type Vip = upstream.Vip
@@ -0,0 +1,48 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var GifOpts = api.ReqOpts{
+	AddEndpoint:         "/interfaces/gif_settings/addItem",
+	GetEndpoint:         "/interfaces/gif_settings/getItem",
+	UpdateEndpoint:      "/interfaces/gif_settings/setItem",
+	DeleteEndpoint:      "/interfaces/gif_settings/delItem",
+	ReconfigureEndpoint: "/interfaces/gif_settings/reconfigure",
+	Monad:               "gif",
+}
+
+// Data structs
+
+type Gif struct {
+	LocalAddress        api.SelectedMap `json:"local-addr"`
+	RemoteAddress       string          `json:"remote-addr"`
+	TunnelLocalAddress  string          `json:"tunnel-local-addr"`
+	TunnelRemoteAddress string          `json:"tunnel-remote-addr"`
+	TunnelRemoteNetmask api.SelectedMap `json:"tunnel-remote-net"`
+	IngressFiltering    string          `json:"link2"`
+	EcnFriendly         string          `json:"link1"`
+	Description         string          `json:"descr"`
+	Device              string          `json:"gifif"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddGif(ctx context.Context, resource *Gif) (string, error) {
+	return api.Add(c.Client(), ctx, GifOpts, resource)
+}
+
+func (c *Controller) GetGif(ctx context.Context, id string) (*Gif, error) {
+	return api.Get(c.Client(), ctx, GifOpts, &Gif{}, id)
+}
+
+func (c *Controller) UpdateGif(ctx context.Context, id string, resource *Gif) error {
+	return api.Update(c.Client(), ctx, GifOpts, resource, id)
+}
+
+func (c *Controller) DeleteGif(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, GifOpts, id)
+}
@@ -0,0 +1,47 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var GreOpts = api.ReqOpts{
+	AddEndpoint:         "/interfaces/gre_settings/addItem",
+	GetEndpoint:         "/interfaces/gre_settings/getItem",
+	UpdateEndpoint:      "/interfaces/gre_settings/setItem",
+	DeleteEndpoint:      "/interfaces/gre_settings/delItem",
+	ReconfigureEndpoint: "/interfaces/gre_settings/reconfigure",
+	Monad:               "gre",
+}
+
+// Data structs
+
+type Gre struct {
+	LocalAddress        api.SelectedMap `json:"local-addr"`
+	RemoteAddress       string          `json:"remote-addr"`
+	TunnelLocalAddress  string          `json:"tunnel-local-addr"`
+	TunnelRemoteAddress string          `json:"tunnel-remote-addr"`
+	TunnelRemoteNetmask api.SelectedMap `json:"tunnel-remote-net"`
+	IngressFiltering    string          `json:"link2"`
+	Description         string          `json:"descr"`
+	Device              string          `json:"greif"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddGre(ctx context.Context, resource *Gre) (string, error) {
+	return api.Add(c.Client(), ctx, GreOpts, resource)
+}
+
+func (c *Controller) GetGre(ctx context.Context, id string) (*Gre, error) {
+	return api.Get(c.Client(), ctx, GreOpts, &Gre{}, id)
+}
+
+func (c *Controller) UpdateGre(ctx context.Context, id string, resource *Gre) error {
+	return api.Update(c.Client(), ctx, GreOpts, resource, id)
+}
+
+func (c *Controller) DeleteGre(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, GreOpts, id)
+}
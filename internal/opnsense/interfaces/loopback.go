@@ -0,0 +1,41 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var LoopbackOpts = api.ReqOpts{
+	AddEndpoint:         "/interfaces/loopback_settings/addItem",
+	GetEndpoint:         "/interfaces/loopback_settings/getItem",
+	UpdateEndpoint:      "/interfaces/loopback_settings/setItem",
+	DeleteEndpoint:      "/interfaces/loopback_settings/delItem",
+	ReconfigureEndpoint: "/interfaces/loopback_settings/reconfigure",
+	Monad:               "loopback",
+}
+
+// Data structs
+
+type Loopback struct {
+	Description string `json:"description"`
+	Device      string `json:"deviceId"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddLoopback(ctx context.Context, resource *Loopback) (string, error) {
+	return api.Add(c.Client(), ctx, LoopbackOpts, resource)
+}
+
+func (c *Controller) GetLoopback(ctx context.Context, id string) (*Loopback, error) {
+	return api.Get(c.Client(), ctx, LoopbackOpts, &Loopback{}, id)
+}
+
+func (c *Controller) UpdateLoopback(ctx context.Context, id string, resource *Loopback) error {
+	return api.Update(c.Client(), ctx, LoopbackOpts, resource, id)
+}
+
+func (c *Controller) DeleteLoopback(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, LoopbackOpts, id)
+}
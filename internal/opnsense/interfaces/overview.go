@@ -0,0 +1,72 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+const overviewEndpoint = "/interfaces/overview/export"
+
+// Data structs
+
+type OverviewInterface struct {
+	Identifier    string
+	Description   string
+	Device        string
+	Enabled       bool
+	Status        string
+	IPv4Addresses []string
+	IPv6Addresses []string
+	Gateways      []string
+}
+
+type overviewAddress struct {
+	IPAddr string `json:"ipaddr"`
+}
+
+type overviewResponse struct {
+	Identifier  string            `json:"identifier"`
+	Description string            `json:"description"`
+	Device      string            `json:"device"`
+	Enabled     bool              `json:"enabled"`
+	Status      string            `json:"status"`
+	IPv4        []overviewAddress `json:"ipv4"`
+	IPv6        []overviewAddress `json:"ipv6"`
+	Gateways    []string          `json:"gateways"`
+}
+
+// Read operations
+
+// GetOverview returns the assigned interfaces, with their addresses and link state.
+func (c *Controller) GetOverview(ctx context.Context) ([]OverviewInterface, error) {
+	var resp []overviewResponse
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: overviewEndpoint, Method: "GET"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := make([]OverviewInterface, 0, len(resp))
+	for _, iface := range resp {
+		overview = append(overview, OverviewInterface{
+			Identifier:    iface.Identifier,
+			Description:   iface.Description,
+			Device:        iface.Device,
+			Enabled:       iface.Enabled,
+			Status:        iface.Status,
+			IPv4Addresses: overviewAddresses(iface.IPv4),
+			IPv6Addresses: overviewAddresses(iface.IPv6),
+			Gateways:      iface.Gateways,
+		})
+	}
+
+	return overview, nil
+}
+
+func overviewAddresses(addresses []overviewAddress) []string {
+	var s []string
+	for _, a := range addresses {
+		s = append(s, a.IPAddr)
+	}
+	return s
+}
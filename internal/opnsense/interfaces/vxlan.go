@@ -0,0 +1,46 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var VxlanOpts = api.ReqOpts{
+	AddEndpoint:         "/interfaces/vxlan_settings/addItem",
+	GetEndpoint:         "/interfaces/vxlan_settings/getItem",
+	UpdateEndpoint:      "/interfaces/vxlan_settings/setItem",
+	DeleteEndpoint:      "/interfaces/vxlan_settings/delItem",
+	ReconfigureEndpoint: "/interfaces/vxlan_settings/reconfigure",
+	Monad:               "vxlan",
+}
+
+// Data structs
+
+type Vxlan struct {
+	VNI             string          `json:"vxlanid"`
+	LocalAddress    string          `json:"vxlanlocal"`
+	RemoteAddress   string          `json:"vxlanremote"`
+	MulticastGroup  string          `json:"vxlangroup"`
+	MulticastDevice api.SelectedMap `json:"vxlandev"`
+	Port            string          `json:"vxlanlocalport"`
+	Device          string          `json:"deviceId"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddVxlan(ctx context.Context, resource *Vxlan) (string, error) {
+	return api.Add(c.Client(), ctx, VxlanOpts, resource)
+}
+
+func (c *Controller) GetVxlan(ctx context.Context, id string) (*Vxlan, error) {
+	return api.Get(c.Client(), ctx, VxlanOpts, &Vxlan{}, id)
+}
+
+func (c *Controller) UpdateVxlan(ctx context.Context, id string, resource *Vxlan) error {
+	return api.Update(c.Client(), ctx, VxlanOpts, resource, id)
+}
+
+func (c *Controller) DeleteVxlan(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, VxlanOpts, id)
+}
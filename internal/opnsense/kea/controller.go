@@ -0,0 +1,28 @@
+// Package kea extends the opnsense-go kea controller.
+package kea
+
+import (
+	upstream "github.com/browningluke/opnsense-go/pkg/kea"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+const keaReconfigureEndpoint = "/kea/service/reconfigure"
+
+// Controller for kea
+type Controller struct {
+	upstream.Controller
+}
+
+// NewController creates a controller using the API client a.
+func NewController(a *api.Client) *Controller {
+	return &Controller{Controller: upstream.Controller{Api: a}}
+}
+
+// Data structs provided by opnsense-go
+
+type (
+	OptionData  = upstream.OptionData
+	Peer        = upstream.Peer
+	Reservation = upstream.Reservation
+	Subnet      = upstream.Subnet
+)
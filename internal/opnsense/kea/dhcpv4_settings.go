@@ -0,0 +1,97 @@
+package kea
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var Dhcpv4SettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/kea/dhcpv4/get",
+	UpdateEndpoint:      "/kea/dhcpv4/set",
+	ReconfigureEndpoint: keaReconfigureEndpoint,
+	Monad:               "dhcpv4",
+}
+
+// Data structs
+
+// Dhcpv4Settings flattens the `general`, `lexpire` and `ha` sections of the DHCPv4 settings.
+type Dhcpv4Settings struct {
+	Enabled          string
+	Interfaces       api.SelectedMapList
+	ValidLifetime    string
+	MaxLifetime      string
+	SocketType       api.SelectedMap
+	FirewallRules    string
+	LeaseExpireSweep string
+
+	HAEnabled         string
+	ThisServerName    string
+	MaxUnackedClients string
+}
+
+type dhcpv4SettingsSections struct {
+	General struct {
+		Enabled       string              `json:"enabled"`
+		Interfaces    api.SelectedMapList `json:"interfaces"`
+		ValidLifetime string              `json:"valid_lifetime"`
+		MaxLifetime   string              `json:"max_valid_lifetime"`
+		SocketType    api.SelectedMap     `json:"dhcp_socket_type"`
+		FirewallRules string              `json:"fwrules"`
+	} `json:"general"`
+	LeaseExpire struct {
+		ReclaimTimerWaitTime string `json:"reclaim_timer_wait_time"`
+	} `json:"lexpire"`
+	HA struct {
+		Enabled           string `json:"enabled"`
+		ThisServerName    string `json:"this_server_name"`
+		MaxUnackedClients string `json:"max_unacked_clients"`
+	} `json:"ha"`
+}
+
+func (s Dhcpv4Settings) MarshalJSON() ([]byte, error) {
+	var sections dhcpv4SettingsSections
+	sections.General.Enabled = s.Enabled
+	sections.General.Interfaces = s.Interfaces
+	sections.General.ValidLifetime = s.ValidLifetime
+	sections.General.MaxLifetime = s.MaxLifetime
+	sections.General.SocketType = s.SocketType
+	sections.General.FirewallRules = s.FirewallRules
+	sections.LeaseExpire.ReclaimTimerWaitTime = s.LeaseExpireSweep
+	sections.HA.Enabled = s.HAEnabled
+	sections.HA.ThisServerName = s.ThisServerName
+	sections.HA.MaxUnackedClients = s.MaxUnackedClients
+	return json.Marshal(sections)
+}
+
+func (s *Dhcpv4Settings) UnmarshalJSON(data []byte) error {
+	var sections dhcpv4SettingsSections
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+
+	*s = Dhcpv4Settings{
+		Enabled:           sections.General.Enabled,
+		Interfaces:        sections.General.Interfaces,
+		ValidLifetime:     sections.General.ValidLifetime,
+		MaxLifetime:       sections.General.MaxLifetime,
+		SocketType:        sections.General.SocketType,
+		FirewallRules:     sections.General.FirewallRules,
+		LeaseExpireSweep:  sections.LeaseExpire.ReclaimTimerWaitTime,
+		HAEnabled:         sections.HA.Enabled,
+		ThisServerName:    sections.HA.ThisServerName,
+		MaxUnackedClients: sections.HA.MaxUnackedClients,
+	}
+	return nil
+}
+
+// Settings operations
+
+func (c *Controller) GetDhcpv4Settings(ctx context.Context) (*Dhcpv4Settings, error) {
+	return api.GetSettings(c.Client(), ctx, Dhcpv4SettingsOpts, &Dhcpv4Settings{})
+}
+
+func (c *Controller) UpdateDhcpv4Settings(ctx context.Context, resource *Dhcpv4Settings) error {
+	return api.UpdateSettings(c.Client(), ctx, Dhcpv4SettingsOpts, resource)
+}
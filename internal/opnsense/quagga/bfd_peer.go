@@ -0,0 +1,50 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var BFDPeerOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bfd/addNeighbor",
+	GetEndpoint:         "/quagga/bfd/getNeighbor",
+	UpdateEndpoint:      "/quagga/bfd/setNeighbor",
+	DeleteEndpoint:      "/quagga/bfd/delNeighbor",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "neighbor",
+}
+
+// Data structs
+
+type BFDPeer struct {
+	Enabled          string          `json:"enabled"`
+	Description      string          `json:"description"`
+	Address          string          `json:"address"`
+	Interface        api.SelectedMap `json:"interface"`
+	LocalAddress     string          `json:"localaddress"`
+	Multihop         string          `json:"multihop"`
+	DetectMultiplier string          `json:"detectmultiplier"`
+	ReceiveInterval  string          `json:"receiveinterval"`
+	TransmitInterval string          `json:"transmitinterval"`
+	EchoMode         string          `json:"echomode"`
+	EchoInterval     string          `json:"echointerval"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddBFDPeer(ctx context.Context, resource *BFDPeer) (string, error) {
+	return api.Add(c.Client(), ctx, BFDPeerOpts, resource)
+}
+
+func (c *Controller) GetBFDPeer(ctx context.Context, id string) (*BFDPeer, error) {
+	return api.Get(c.Client(), ctx, BFDPeerOpts, &BFDPeer{}, id)
+}
+
+func (c *Controller) UpdateBFDPeer(ctx context.Context, id string, resource *BFDPeer) error {
+	return api.Update(c.Client(), ctx, BFDPeerOpts, resource, id)
+}
+
+func (c *Controller) DeleteBFDPeer(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, BFDPeerOpts, id)
+}
@@ -0,0 +1,189 @@
+package quagga
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+const (
+	bgpSummaryEndpoint = "/quagga/diagnostics/bgpsummary"
+	bgpRoutesEndpoint  = "/quagga/diagnostics/bgproute"
+)
+
+// Data structs
+
+// BGPSummary is the output of `show bgp summary`, with one peer per neighbor and address family.
+type BGPSummary struct {
+	RouterID string
+	ASNumber string
+	Peers    []BGPSummaryPeer
+}
+
+type BGPSummaryPeer struct {
+	Neighbor         string
+	AddressFamily    string
+	Description      string
+	RemoteAS         string
+	State            string
+	Uptime           string
+	UptimeSeconds    string
+	PrefixesReceived string
+	PrefixesSent     string
+}
+
+// BGPRoute is a single path of the BGP table.
+type BGPRoute struct {
+	Network         string
+	NextHop         string
+	Path            string
+	Origin          string
+	LocalPreference string
+	Metric          string
+	Weight          string
+	Valid           string
+	Best            string
+	Protocol        string
+}
+
+type bgpSummaryFamily struct {
+	RouterID string                    `json:"routerId"`
+	AS       json.Number               `json:"as"`
+	Peers    map[string]bgpSummaryPeer `json:"peers"`
+}
+
+type bgpSummaryPeer struct {
+	Description    string       `json:"desc"`
+	RemoteAS       json.Number  `json:"remoteAs"`
+	State          string       `json:"state"`
+	PeerUptime     string       `json:"peerUptime"`
+	PeerUptimeMsec *json.Number `json:"peerUptimeMsec"`
+	PfxRcd         *json.Number `json:"pfxRcd"`
+	PfxSnt         *json.Number `json:"pfxSnt"`
+}
+
+type bgpTable struct {
+	Routes map[string][]bgpPath `json:"routes"`
+}
+
+type bgpPath struct {
+	Valid    bool         `json:"valid"`
+	BestPath bool         `json:"bestpath"`
+	Path     string       `json:"path"`
+	Origin   string       `json:"origin"`
+	LocPrf   *json.Number `json:"locPrf"`
+	Metric   *json.Number `json:"metric"`
+	Weight   json.Number  `json:"weight"`
+	NextHops []struct {
+		IP string `json:"ip"`
+	} `json:"nexthops"`
+}
+
+// Read operations
+
+func (c *Controller) GetBGPSummary(ctx context.Context) (*BGPSummary, error) {
+	var resp map[string]bgpSummaryFamily
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: bgpSummaryEndpoint, Method: "GET"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &BGPSummary{Peers: []BGPSummaryPeer{}}
+	for _, family := range sortedKeys(resp) {
+		f := resp[family]
+		if summary.RouterID == "" {
+			summary.RouterID = f.RouterID
+			summary.ASNumber = f.AS.String()
+		}
+
+		for _, neighbor := range sortedKeys(f.Peers) {
+			p := f.Peers[neighbor]
+			summary.Peers = append(summary.Peers, BGPSummaryPeer{
+				Neighbor:         neighbor,
+				AddressFamily:    family,
+				Description:      p.Description,
+				RemoteAS:         p.RemoteAS.String(),
+				State:            p.State,
+				Uptime:           p.PeerUptime,
+				UptimeSeconds:    msecToSeconds(p.PeerUptimeMsec),
+				PrefixesReceived: numberOrEmpty(p.PfxRcd),
+				PrefixesSent:     numberOrEmpty(p.PfxSnt),
+			})
+		}
+	}
+
+	return summary, nil
+}
+
+func (c *Controller) GetBGPRoutes(ctx context.Context) ([]BGPRoute, error) {
+	var resp map[string]bgpTable
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: bgpRoutesEndpoint, Method: "GET"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := []BGPRoute{}
+	for _, protocol := range sortedKeys(resp) {
+		table := resp[protocol]
+		for _, network := range sortedKeys(table.Routes) {
+			for _, p := range table.Routes[network] {
+				nextHop := ""
+				if len(p.NextHops) > 0 {
+					nextHop = p.NextHops[0].IP
+				}
+
+				routes = append(routes, BGPRoute{
+					Network:         network,
+					NextHop:         nextHop,
+					Path:            p.Path,
+					Origin:          p.Origin,
+					LocalPreference: numberOrEmpty(p.LocPrf),
+					Metric:          numberOrEmpty(p.Metric),
+					Weight:          p.Weight.String(),
+					Valid:           boolToString(p.Valid),
+					Best:            boolToString(p.BestPath),
+					Protocol:        protocol,
+				})
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func numberOrEmpty(n *json.Number) string {
+	if n == nil {
+		return ""
+	}
+	return n.String()
+}
+
+func msecToSeconds(n *json.Number) string {
+	if n == nil {
+		return ""
+	}
+	msec, err := n.Int64()
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatInt(msec/1000, 10)
+}
+
+func boolToString(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,81 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var BGPNeighborOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bgp/addNeighbor",
+	GetEndpoint:         "/quagga/bgp/getNeighbor",
+	UpdateEndpoint:      "/quagga/bgp/setNeighbor",
+	DeleteEndpoint:      "/quagga/bgp/delNeighbor",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "neighbor",
+}
+
+// Data structs
+
+type BGPNeighbor struct {
+	Enabled                        string          `json:"enabled"`
+	Description                    string          `json:"description"`
+	PeerIP                         string          `json:"address"`
+	RemoteAS                       string          `json:"remoteas"`
+	Password                       string          `json:"password"`
+	Weight                         string          `json:"weight"`
+	LocalIP                        string          `json:"localip"`
+	UpdateSource                   api.SelectedMap `json:"updatesource"`
+	LinkLocalInterface             api.SelectedMap `json:"linklocalinterface"`
+	NextHopSelf                    string          `json:"nexthopself"`
+	NextHopSelfAll                 string          `json:"nexthopselfall"`
+	MultiHop                       string          `json:"multihop"`
+	MultiProtocol                  string          `json:"multiprotocol"`
+	RRClient                       string          `json:"rrclient"`
+	BFD                            string          `json:"bfd"`
+	KeepAlive                      string          `json:"keepalive"`
+	HoldDown                       string          `json:"holddown"`
+	ConnectTimer                   string          `json:"connecttimer"`
+	DefaultRoute                   string          `json:"defaultoriginate"`
+	ASOverride                     string          `json:"asoverride"`
+	DisableConnectedCheck          string          `json:"disable_connected_check"`
+	AttributeUnchanged             api.SelectedMap `json:"attributeunchanged"`
+	PrefixListIn                   api.SelectedMap `json:"linkedPrefixlistIn"`
+	PrefixListOut                  api.SelectedMap `json:"linkedPrefixlistOut"`
+	RouteMapIn                     api.SelectedMap `json:"linkedRoutemapIn"`
+	RouteMapOut                    api.SelectedMap `json:"linkedRoutemapOut"`
+	PeerGroup                      api.SelectedMap `json:"peergroup"`
+	Shutdown                       string          `json:"shutdown"`
+	IPv4Activate                   string          `json:"ipv4_activate"`
+	IPv4AllowASIn                  string          `json:"ipv4_allowas_in"`
+	IPv4MaximumPrefix              string          `json:"ipv4_maximum_prefix"`
+	IPv4MaximumPrefixRestart       string          `json:"ipv4_maximum_prefix_restart"`
+	IPv4MaximumPrefixWarningOnly   string          `json:"ipv4_maximum_prefix_warning_only"`
+	IPv4SoftReconfigurationInbound string          `json:"ipv4_soft_reconfiguration_inbound"`
+	IPv4SendCommunity              api.SelectedMap `json:"ipv4_send_community"`
+	IPv6Activate                   string          `json:"ipv6_activate"`
+	IPv6AllowASIn                  string          `json:"ipv6_allowas_in"`
+	IPv6MaximumPrefix              string          `json:"ipv6_maximum_prefix"`
+	IPv6MaximumPrefixRestart       string          `json:"ipv6_maximum_prefix_restart"`
+	IPv6MaximumPrefixWarningOnly   string          `json:"ipv6_maximum_prefix_warning_only"`
+	IPv6SoftReconfigurationInbound string          `json:"ipv6_soft_reconfiguration_inbound"`
+	IPv6SendCommunity              api.SelectedMap `json:"ipv6_send_community"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddBGPNeighbor(ctx context.Context, resource *BGPNeighbor) (string, error) {
+	return api.Add(c.Client(), ctx, BGPNeighborOpts, resource)
+}
+
+func (c *Controller) GetBGPNeighbor(ctx context.Context, id string) (*BGPNeighbor, error) {
+	return api.Get(c.Client(), ctx, BGPNeighborOpts, &BGPNeighbor{}, id)
+}
+
+func (c *Controller) UpdateBGPNeighbor(ctx context.Context, id string, resource *BGPNeighbor) error {
+	return api.Update(c.Client(), ctx, BGPNeighborOpts, resource, id)
+}
+
+func (c *Controller) DeleteBGPNeighbor(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, BGPNeighborOpts, id)
+}
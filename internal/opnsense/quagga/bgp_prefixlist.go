@@ -0,0 +1,50 @@
+package quagga
+
+import (
+	"context"
+	"fmt"
+
+	upstream "github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Unlike AddBGPPrefixList and friends, these operations do not reconfigure FRR after every change, so a batch of
+// prefix list entries can be applied with a single QuaggaReconfigure.
+
+func (c *Controller) QuaggaAddBGPPrefixList(ctx context.Context, resource BGPPrefixList) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, upstream.BGPPrefixListOpts.AddEndpoint, map[string]any{
+		upstream.BGPPrefixListOpts.Monad: resource,
+	})
+}
+
+func (c *Controller) QuaggaSetBGPPrefixList(ctx context.Context, id string, resource BGPPrefixList) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, fmt.Sprintf("%s/%s", upstream.BGPPrefixListOpts.UpdateEndpoint, id), map[string]any{
+		upstream.BGPPrefixListOpts.Monad: resource,
+	})
+}
+
+func (c *Controller) QuaggaDelBGPPrefixList(ctx context.Context, id string) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, fmt.Sprintf("%s/%s", upstream.BGPPrefixListOpts.DeleteEndpoint, id), nil)
+}
+
+func (c *Controller) QuaggaReconfigure(ctx context.Context) (*api.ActionResult, error) {
+	return api.Reconfigure(c.Client(), ctx, quaggaReconfigureEndpoint)
+}
+
+// Data structs
+
+type BGPPrefixListRow struct {
+	UUID           string `json:"uuid"`
+	Name           string `json:"name"`
+	SequenceNumber string `json:"seqnumber"`
+}
+
+// Search operations
+
+func (c *Controller) SearchBGPPrefixLists(ctx context.Context) ([]BGPPrefixListRow, error) {
+	res, err := api.Search[BGPPrefixListRow](c.Client(), ctx, "/quagga/bgp/searchPrefixlist")
+	if err != nil {
+		return nil, err
+	}
+	return res.Rows, nil
+}
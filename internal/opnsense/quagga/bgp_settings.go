@@ -0,0 +1,37 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var BGPSettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/quagga/bgp/get",
+	UpdateEndpoint:      "/quagga/bgp/set",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "bgp",
+}
+
+// Data structs
+
+type BGPSettings struct {
+	Enabled            string              `json:"enabled"`
+	ASNumber           string              `json:"asnumber"`
+	RouterID           string              `json:"routerid"`
+	Networks           api.SelectedMapList `json:"networks"`
+	Redistribute       api.SelectedMapList `json:"redistribute"`
+	LogNeighborChanges string              `json:"logneighborchanges"`
+	GracefulRestart    string              `json:"graceful"`
+	Multipath          string              `json:"multipath"`
+}
+
+// Settings operations
+
+func (c *Controller) GetBGPSettings(ctx context.Context) (*BGPSettings, error) {
+	return api.GetSettings(c.Client(), ctx, BGPSettingsOpts, &BGPSettings{})
+}
+
+func (c *Controller) UpdateBGPSettings(ctx context.Context, resource *BGPSettings) error {
+	return api.UpdateSettings(c.Client(), ctx, BGPSettingsOpts, resource)
+}
@@ -0,0 +1,28 @@
+// Package quagga extends the opnsense-go quagga (os-frr) controller.
+package quagga
+
+import (
+	upstream "github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+const quaggaReconfigureEndpoint = "/quagga/service/reconfigure"
+
+// Controller for quagga
+type Controller struct {
+	upstream.Controller
+}
+
+// NewController creates a controller using the API client a.
+func NewController(a *api.Client) *Controller {
+	return &Controller{Controller: upstream.Controller{Api: a}}
+}
+
+// Data structs provided by opnsense-go
+
+type (
+	BGPASPath        = upstream.BGPASPath
+	BGPCommunityList = upstream.BGPCommunityList
+	BGPPrefixList    = upstream.BGPPrefixList
+	BGPRouteMap      = upstream.BGPRouteMap
+)
@@ -0,0 +1,36 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var GeneralSettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/quagga/general/get",
+	UpdateEndpoint:      "/quagga/general/set",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "general",
+}
+
+// Data structs
+
+type GeneralSettings struct {
+	Enabled        string          `json:"enabled"`
+	Profile        api.SelectedMap `json:"profile"`
+	CARPAware      string          `json:"enablecarp"`
+	Syslog         string          `json:"enablesyslog"`
+	LogLevel       api.SelectedMap `json:"sysloglevel"`
+	SyslogFacility api.SelectedMap `json:"syslogfacility"`
+	FirewallRules  string          `json:"fwrules"`
+}
+
+// Settings operations
+
+func (c *Controller) GetGeneralSettings(ctx context.Context) (*GeneralSettings, error) {
+	return api.GetSettings(c.Client(), ctx, GeneralSettingsOpts, &GeneralSettings{})
+}
+
+func (c *Controller) UpdateGeneralSettings(ctx context.Context, resource *GeneralSettings) error {
+	return api.UpdateSettings(c.Client(), ctx, GeneralSettingsOpts, resource)
+}
@@ -0,0 +1,42 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var OSPF6AreaOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospf6settings/addArea",
+	GetEndpoint:         "/quagga/ospf6settings/getArea",
+	UpdateEndpoint:      "/quagga/ospf6settings/setArea",
+	DeleteEndpoint:      "/quagga/ospf6settings/delArea",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "area",
+}
+
+// Data structs
+
+type OSPF6Area struct {
+	AreaID      string          `json:"area"`
+	Type        api.SelectedMap `json:"type"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddOSPF6Area(ctx context.Context, resource *OSPF6Area) (string, error) {
+	return api.Add(c.Client(), ctx, OSPF6AreaOpts, resource)
+}
+
+func (c *Controller) GetOSPF6Area(ctx context.Context, id string) (*OSPF6Area, error) {
+	return api.Get(c.Client(), ctx, OSPF6AreaOpts, &OSPF6Area{}, id)
+}
+
+func (c *Controller) UpdateOSPF6Area(ctx context.Context, id string, resource *OSPF6Area) error {
+	return api.Update(c.Client(), ctx, OSPF6AreaOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPF6Area(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPF6AreaOpts, id)
+}
@@ -0,0 +1,50 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var OSPF6InterfaceOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospf6settings/addInterface",
+	GetEndpoint:         "/quagga/ospf6settings/getInterface",
+	UpdateEndpoint:      "/quagga/ospf6settings/setInterface",
+	DeleteEndpoint:      "/quagga/ospf6settings/delInterface",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "interface",
+}
+
+// Data structs
+
+type OSPF6Interface struct {
+	Enabled            string          `json:"enabled"`
+	Interface          api.SelectedMap `json:"interfacename"`
+	Area               string          `json:"area"`
+	NetworkType        api.SelectedMap `json:"networktype"`
+	Passive            string          `json:"passive"`
+	Cost               string          `json:"cost"`
+	Priority           string          `json:"priority"`
+	HelloInterval      string          `json:"hellointerval"`
+	DeadInterval       string          `json:"deadinterval"`
+	RetransmitInterval string          `json:"retransmitinterval"`
+	TransmitDelay      string          `json:"transmitdelay"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddOSPF6Interface(ctx context.Context, resource *OSPF6Interface) (string, error) {
+	return api.Add(c.Client(), ctx, OSPF6InterfaceOpts, resource)
+}
+
+func (c *Controller) GetOSPF6Interface(ctx context.Context, id string) (*OSPF6Interface, error) {
+	return api.Get(c.Client(), ctx, OSPF6InterfaceOpts, &OSPF6Interface{}, id)
+}
+
+func (c *Controller) UpdateOSPF6Interface(ctx context.Context, id string, resource *OSPF6Interface) error {
+	return api.Update(c.Client(), ctx, OSPF6InterfaceOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPF6Interface(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPF6InterfaceOpts, id)
+}
@@ -0,0 +1,37 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var OSPF6SettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/quagga/ospf6settings/get",
+	UpdateEndpoint:      "/quagga/ospf6settings/set",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "ospf6",
+}
+
+// Data structs
+
+type OSPF6Settings struct {
+	Enabled                string              `json:"enabled"`
+	RouterID               string              `json:"routerid"`
+	PassiveDefault         string              `json:"passiveinterface_default"`
+	Redistribute           api.SelectedMapList `json:"redistribute"`
+	RedistributeRouteMap   api.SelectedMap     `json:"redistributemap"`
+	DefaultOriginate       string              `json:"originate"`
+	DefaultOriginateAlways string              `json:"originatealways"`
+	DefaultOriginateMetric string              `json:"originatemetric"`
+}
+
+// Settings operations
+
+func (c *Controller) GetOSPF6Settings(ctx context.Context) (*OSPF6Settings, error) {
+	return api.GetSettings(c.Client(), ctx, OSPF6SettingsOpts, &OSPF6Settings{})
+}
+
+func (c *Controller) UpdateOSPF6Settings(ctx context.Context, resource *OSPF6Settings) error {
+	return api.UpdateSettings(c.Client(), ctx, OSPF6SettingsOpts, resource)
+}
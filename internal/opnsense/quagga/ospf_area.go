@@ -0,0 +1,42 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var OSPFAreaOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospfsettings/addArea",
+	GetEndpoint:         "/quagga/ospfsettings/getArea",
+	UpdateEndpoint:      "/quagga/ospfsettings/setArea",
+	DeleteEndpoint:      "/quagga/ospfsettings/delArea",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "area",
+}
+
+// Data structs
+
+type OSPFArea struct {
+	AreaID      string          `json:"area"`
+	Type        api.SelectedMap `json:"type"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddOSPFArea(ctx context.Context, resource *OSPFArea) (string, error) {
+	return api.Add(c.Client(), ctx, OSPFAreaOpts, resource)
+}
+
+func (c *Controller) GetOSPFArea(ctx context.Context, id string) (*OSPFArea, error) {
+	return api.Get(c.Client(), ctx, OSPFAreaOpts, &OSPFArea{}, id)
+}
+
+func (c *Controller) UpdateOSPFArea(ctx context.Context, id string, resource *OSPFArea) error {
+	return api.Update(c.Client(), ctx, OSPFAreaOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPFArea(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPFAreaOpts, id)
+}
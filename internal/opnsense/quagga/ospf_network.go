@@ -0,0 +1,46 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var OSPFNetworkOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospfsettings/addNetwork",
+	GetEndpoint:         "/quagga/ospfsettings/getNetwork",
+	UpdateEndpoint:      "/quagga/ospfsettings/setNetwork",
+	DeleteEndpoint:      "/quagga/ospfsettings/delNetwork",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "network",
+}
+
+// Data structs
+
+type OSPFNetwork struct {
+	Enabled       string          `json:"enabled"`
+	Address       string          `json:"ipaddr"`
+	Mask          string          `json:"netmask"`
+	Area          string          `json:"area"`
+	AreaRange     string          `json:"arearange"`
+	PrefixListIn  api.SelectedMap `json:"linkedPrefixlistIn"`
+	PrefixListOut api.SelectedMap `json:"linkedPrefixlistOut"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddOSPFNetwork(ctx context.Context, resource *OSPFNetwork) (string, error) {
+	return api.Add(c.Client(), ctx, OSPFNetworkOpts, resource)
+}
+
+func (c *Controller) GetOSPFNetwork(ctx context.Context, id string) (*OSPFNetwork, error) {
+	return api.Get(c.Client(), ctx, OSPFNetworkOpts, &OSPFNetwork{}, id)
+}
+
+func (c *Controller) UpdateOSPFNetwork(ctx context.Context, id string, resource *OSPFNetwork) error {
+	return api.Update(c.Client(), ctx, OSPFNetworkOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPFNetwork(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPFNetworkOpts, id)
+}
@@ -0,0 +1,37 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var OSPFSettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/quagga/ospfsettings/get",
+	UpdateEndpoint:      "/quagga/ospfsettings/set",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "ospf",
+}
+
+// Data structs
+
+type OSPFSettings struct {
+	Enabled                string              `json:"enabled"`
+	RouterID               string              `json:"routerid"`
+	PassiveDefault         string              `json:"passiveinterface_default"`
+	Redistribute           api.SelectedMapList `json:"redistribute"`
+	RedistributeRouteMap   api.SelectedMap     `json:"redistributemap"`
+	DefaultOriginate       string              `json:"originate"`
+	DefaultOriginateAlways string              `json:"originatealways"`
+	DefaultOriginateMetric string              `json:"originatemetric"`
+}
+
+// Settings operations
+
+func (c *Controller) GetOSPFSettings(ctx context.Context) (*OSPFSettings, error) {
+	return api.GetSettings(c.Client(), ctx, OSPFSettingsOpts, &OSPFSettings{})
+}
+
+func (c *Controller) UpdateOSPFSettings(ctx context.Context, resource *OSPFSettings) error {
+	return api.UpdateSettings(c.Client(), ctx, OSPFSettingsOpts, resource)
+}
@@ -0,0 +1,45 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var StaticRouteOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/static/addRoute",
+	GetEndpoint:         "/quagga/static/getRoute",
+	UpdateEndpoint:      "/quagga/static/setRoute",
+	DeleteEndpoint:      "/quagga/static/delRoute",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "route",
+}
+
+// Data structs
+
+type StaticRoute struct {
+	Enabled     string          `json:"enabled"`
+	Description string          `json:"description"`
+	Network     string          `json:"network"`
+	Gateway     string          `json:"gateway"`
+	Interface   api.SelectedMap `json:"interfacename"`
+	Distance    string          `json:"distance"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddStaticRoute(ctx context.Context, resource *StaticRoute) (string, error) {
+	return api.Add(c.Client(), ctx, StaticRouteOpts, resource)
+}
+
+func (c *Controller) GetStaticRoute(ctx context.Context, id string) (*StaticRoute, error) {
+	return api.Get(c.Client(), ctx, StaticRouteOpts, &StaticRoute{}, id)
+}
+
+func (c *Controller) UpdateStaticRoute(ctx context.Context, id string, resource *StaticRoute) error {
+	return api.Update(c.Client(), ctx, StaticRouteOpts, resource, id)
+}
+
+func (c *Controller) DeleteStaticRoute(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, StaticRouteOpts, id)
+}
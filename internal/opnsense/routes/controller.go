@@ -0,0 +1,21 @@
+// Package routes extends the opnsense-go routes controller.
+package routes
+
+import (
+	upstream "github.com/browningluke/opnsense-go/pkg/routes"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Controller for routes
+type Controller struct {
+	upstream.Controller
+}
+
+// NewController creates a controller using the API client a.
+func NewController(a *api.Client) *Controller {
+	return &Controller{Controller: upstream.Controller{Api: a}}
+}
+
+// Data structs provided by opnsense-go
+
+type Route = upstream.Route
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Data structs
+
+type RouteRow struct {
+	UUID        string `json:"uuid"`
+	Network     string `json:"network"`
+	Gateway     string `json:"gateway"`
+	Description string `json:"descr"`
+	Disabled    string `json:"disabled"`
+}
+
+// Search operations
+
+func (c *Controller) SearchRoutes(ctx context.Context) ([]RouteRow, error) {
+	res, err := api.Search[RouteRow](c.Client(), ctx, "/routes/routes/searchroute")
+	if err != nil {
+		return nil, err
+	}
+	return res.Rows, nil
+}
@@ -0,0 +1,93 @@
+package unbound
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var ACLOpts = api.ReqOpts{
+	AddEndpoint:         "/unbound/settings/addAcl",
+	GetEndpoint:         "/unbound/settings/getAcl",
+	UpdateEndpoint:      "/unbound/settings/setAcl",
+	DeleteEndpoint:      "/unbound/settings/delAcl",
+	ReconfigureEndpoint: unboundReconfigureEndpoint,
+	Monad:               "acl",
+}
+
+// Data structs
+
+type ACL struct {
+	Enabled     string              `json:"enabled"`
+	Name        string              `json:"name"`
+	Action      api.SelectedMap     `json:"action"`
+	Networks    api.SelectedMapList `json:"networks"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddACL(ctx context.Context, resource *ACL) (string, error) {
+	return api.Add(c.Client(), ctx, ACLOpts, resource)
+}
+
+func (c *Controller) GetACL(ctx context.Context, id string) (*ACL, error) {
+	return api.Get(c.Client(), ctx, ACLOpts, &ACL{}, id)
+}
+
+func (c *Controller) UpdateACL(ctx context.Context, id string, resource *ACL) error {
+	return api.Update(c.Client(), ctx, ACLOpts, resource, id)
+}
+
+func (c *Controller) DeleteACL(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ACLOpts, id)
+}
+
+type ACLSearchRow struct {
+	UUID        string `json:"uuid"`
+	Enabled     string `json:"enabled"`
+	Name        string `json:"name"`
+	Action      string `json:"action"`
+	Networks    string `json:"networks"`
+	Description string `json:"description"`
+}
+
+// aclSettings is the part of the unbound settings holding the action for clients not matched by any ACL.
+type aclSettings struct {
+	ACLs struct {
+		DefaultAction api.SelectedMap `json:"default_action"`
+	} `json:"acls"`
+}
+
+var aclSettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/unbound/settings/get",
+	UpdateEndpoint:      "/unbound/settings/set",
+	ReconfigureEndpoint: unboundReconfigureEndpoint,
+	Monad:               "unbound",
+}
+
+// Search operations
+
+func (c *Controller) SearchACLs(ctx context.Context) ([]ACLSearchRow, error) {
+	res, err := api.Search[ACLSearchRow](c.Client(), ctx, "/unbound/settings/searchAcl")
+	if err != nil {
+		return nil, err
+	}
+	return res.Rows, nil
+}
+
+// Default action operations
+
+func (c *Controller) GetACLDefaultAction(ctx context.Context) (string, error) {
+	settings, err := api.GetSettings(c.Client(), ctx, aclSettingsOpts, &aclSettings{})
+	if err != nil {
+		return "", err
+	}
+	return settings.ACLs.DefaultAction.String(), nil
+}
+
+func (c *Controller) UpdateACLDefaultAction(ctx context.Context, action string) error {
+	settings := &aclSettings{}
+	settings.ACLs.DefaultAction = api.SelectedMap(action)
+	return api.UpdateSettings(c.Client(), ctx, aclSettingsOpts, settings)
+}
@@ -0,0 +1,27 @@
+// Package unbound extends the opnsense-go unbound controller.
+package unbound
+
+import (
+	upstream "github.com/browningluke/opnsense-go/pkg/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+const unboundReconfigureEndpoint = "/unbound/service/reconfigure"
+
+// Controller for unbound
+type Controller struct {
+	upstream.Controller
+}
+
+// NewController creates a controller using the API client a.
+func NewController(a *api.Client) *Controller {
+	return &Controller{Controller: upstream.Controller{Api: a}}
+}
+
+// Data structs provided by opnsense-go
+
+type (
+	DomainOverride = upstream.DomainOverride
+	HostAlias      = upstream.HostAlias
+	HostOverride   = upstream.HostOverride
+)
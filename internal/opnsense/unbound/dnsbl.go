@@ -0,0 +1,75 @@
+package unbound
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var DNSBLOpts = api.ReqOpts{
+	AddEndpoint:         "/unbound/settings/addDnsbl",
+	GetEndpoint:         "/unbound/settings/getDnsbl",
+	UpdateEndpoint:      "/unbound/settings/setDnsbl",
+	DeleteEndpoint:      "/unbound/settings/delDnsbl",
+	ReconfigureEndpoint: unboundReconfigureEndpoint,
+	Monad:               "blocklist",
+}
+
+// Data structs
+
+type DNSBL struct {
+	Enabled         string              `json:"enabled"`
+	Description     string              `json:"description"`
+	Providers       api.SelectedMapList `json:"type"`
+	CustomURLs      api.SelectedMapList `json:"lists"`
+	Allowlist       api.SelectedMapList `json:"allowlists"`
+	Blocklist       api.SelectedMapList `json:"blocklists"`
+	Wildcards       api.SelectedMapList `json:"wildcards"`
+	SourceNetworks  api.SelectedMapList `json:"source_nets"`
+	Address         string              `json:"address"`
+	NXDomain        string              `json:"nxdomain"`
+	RefreshInterval string              `json:"refresh"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDNSBL(ctx context.Context, resource *DNSBL) (string, error) {
+	return api.Add(c.Client(), ctx, DNSBLOpts, resource)
+}
+
+func (c *Controller) GetDNSBL(ctx context.Context, id string) (*DNSBL, error) {
+	return api.Get(c.Client(), ctx, DNSBLOpts, &DNSBL{}, id)
+}
+
+func (c *Controller) UpdateDNSBL(ctx context.Context, id string, resource *DNSBL) error {
+	return api.Update(c.Client(), ctx, DNSBLOpts, resource, id)
+}
+
+func (c *Controller) DeleteDNSBL(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DNSBLOpts, id)
+}
+
+// DNSBLStatus reports the blocklist entries loaded by the running resolver. EntryCount is empty if no blocklist has
+// been loaded yet.
+type DNSBLStatus struct {
+	EntryCount string
+}
+
+// Status operations
+
+func (c *Controller) GetDNSBLStatus(ctx context.Context) (*DNSBLStatus, error) {
+	var resp struct {
+		BlocklistSize *json.Number `json:"blocklist_size"`
+	}
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: "/unbound/overview/totals", Method: "GET"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &DNSBLStatus{}
+	if resp.BlocklistSize != nil {
+		status.EntryCount = resp.BlocklistSize.String()
+	}
+	return status, nil
+}
@@ -0,0 +1,41 @@
+package unbound
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Data structs
+
+type ForwardRow struct {
+	UUID         string `json:"uuid"`
+	Enabled      string `json:"enabled"`
+	Type         string `json:"type"`
+	Domain       string `json:"domain"`
+	Server       string `json:"server"`
+	Port         string `json:"port"`
+	VerifyCN     string `json:"verify"`
+	ForwardFirst string `json:"forward_first"`
+}
+
+// ForwardZone is a forward zone loaded by the running resolver, as listed by `unbound-control list_forwards`.
+type ForwardZone struct {
+	Name    string   `json:"name"`
+	Servers []string `json:"servers"`
+}
+
+// Read operations
+
+func (c *Controller) UnboundSearchForwards(ctx context.Context) (*api.SearchResult[ForwardRow], error) {
+	return api.Search[ForwardRow](c.Client(), ctx, "/unbound/settings/searchDot")
+}
+
+func (c *Controller) UnboundListForwards(ctx context.Context) ([]ForwardZone, error) {
+	var zones []ForwardZone
+	_, err := api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: "/unbound/diagnostics/listforwards", Method: "GET"}, &zones)
+	if err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
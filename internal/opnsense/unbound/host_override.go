@@ -0,0 +1,60 @@
+package unbound
+
+import (
+	"context"
+	"fmt"
+
+	upstream "github.com/browningluke/opnsense-go/pkg/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+// Data structs
+
+// HostOverrideSet is a host override as sent to OPNsense. Unlike HostOverride, it includes the TXT record data.
+type HostOverrideSet struct {
+	Enabled     string `json:"enabled"`
+	Hostname    string `json:"hostname"`
+	Domain      string `json:"domain"`
+	RR          string `json:"rr"`
+	Server      string `json:"server"`
+	MX          string `json:"mx"`
+	MXPrio      string `json:"mxprio"`
+	TXTData     string `json:"txtdata"`
+	Description string `json:"description"`
+}
+
+type HostOverrideRow struct {
+	UUID        string `json:"uuid"`
+	Enabled     string `json:"enabled"`
+	Hostname    string `json:"hostname"`
+	Domain      string `json:"domain"`
+	RR          string `json:"rr"`
+	Server      string `json:"server"`
+	MX          string `json:"mx"`
+	MXPrio      string `json:"mxprio"`
+	TXTData     string `json:"txtdata"`
+	Description string `json:"description"`
+}
+
+// Unlike AddHostOverride and friends, these operations do not reconfigure unbound after every change, so a batch of
+// host overrides can be applied with a single UnboundReconfigure.
+
+func (c *Controller) UnboundSearchHostOverrides(ctx context.Context) (*api.SearchResult[HostOverrideRow], error) {
+	return api.Search[HostOverrideRow](c.Client(), ctx, "/unbound/settings/searchHostOverride")
+}
+
+func (c *Controller) UnboundAddHostOverride(ctx context.Context, resource HostOverrideSet) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, upstream.HostOverrideOpts.AddEndpoint, map[string]any{
+		upstream.HostOverrideOpts.Monad: resource,
+	})
+}
+
+func (c *Controller) UnboundSetHostOverride(ctx context.Context, id string, resource HostOverrideSet) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, fmt.Sprintf("%s/%s", upstream.HostOverrideOpts.UpdateEndpoint, id), map[string]any{
+		upstream.HostOverrideOpts.Monad: resource,
+	})
+}
+
+func (c *Controller) UnboundDelHostOverride(ctx context.Context, id string) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, fmt.Sprintf("%s/%s", upstream.HostOverrideOpts.DeleteEndpoint, id), nil)
+}
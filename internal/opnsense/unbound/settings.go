@@ -0,0 +1,110 @@
+package unbound
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+)
+
+var SettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/unbound/settings/get",
+	UpdateEndpoint:      "/unbound/settings/set",
+	ReconfigureEndpoint: unboundReconfigureEndpoint,
+	Monad:               "unbound",
+}
+
+// Data structs
+
+// SettingsGetResponse is the unbound settings as returned by OPNsense, with every option of option fields.
+type SettingsGetResponse struct {
+	Unbound Settings `json:"unbound"`
+}
+
+type Settings struct {
+	General    GeneralSettings    `json:"general"`
+	Advanced   AdvancedSettings   `json:"advanced"`
+	Forwarding ForwardingSettings `json:"forwarding"`
+}
+
+type GeneralSettings struct {
+	Enabled            string           `json:"enabled"`
+	Port               string           `json:"port"`
+	ActiveInterface    api.FieldOptions `json:"active_interface"`
+	DNSSEC             string           `json:"dnssec"`
+	DNS64              string           `json:"dns64"`
+	DNS64Prefix        string           `json:"dns64prefix"`
+	RegisterDHCP       string           `json:"regdhcp"`
+	RegisterDHCPStatic string           `json:"regdhcpstatic"`
+	LocalZoneType      api.FieldOptions `json:"local_zone_type"`
+}
+
+type AdvancedSettings struct {
+	Prefetch                string           `json:"prefetch"`
+	PrefetchKey             string           `json:"prefetchkey"`
+	ServeExpired            string           `json:"serveexpired"`
+	ServeExpiredTTL         string           `json:"serveexpiredttl"`
+	MessageCacheSize        api.FieldOptions `json:"msgcachesize"`
+	RRSetCacheSize          api.FieldOptions `json:"rrsetcachesize"`
+	QnameMinimisation       string           `json:"qnamemin"`
+	QnameMinimisationStrict string           `json:"qnameminstrict"`
+	PrivateDomain           api.FieldOptions `json:"privatedomain"`
+	InsecureDomain          api.FieldOptions `json:"insecuredomain"`
+}
+
+type ForwardingSettings struct {
+	Enabled string           `json:"enabled"`
+	Mode    api.FieldOptions `json:"mode"`
+}
+
+// SettingsSetRequest is the unbound settings as sent to OPNsense. Fields that allow several values are
+// comma-separated.
+type SettingsSetRequest struct {
+	General    GeneralSet    `json:"general"`
+	Advanced   AdvancedSet   `json:"advanced"`
+	Forwarding ForwardingSet `json:"forwarding"`
+}
+
+type GeneralSet struct {
+	Enabled            string `json:"enabled"`
+	Port               string `json:"port"`
+	ActiveInterface    string `json:"active_interface"`
+	DNSSEC             string `json:"dnssec"`
+	DNS64              string `json:"dns64"`
+	DNS64Prefix        string `json:"dns64prefix"`
+	RegisterDHCP       string `json:"regdhcp"`
+	RegisterDHCPStatic string `json:"regdhcpstatic"`
+	LocalZoneType      string `json:"local_zone_type"`
+}
+
+type AdvancedSet struct {
+	Prefetch                string `json:"prefetch"`
+	PrefetchKey             string `json:"prefetchkey"`
+	ServeExpired            string `json:"serveexpired"`
+	ServeExpiredTTL         string `json:"serveexpiredttl"`
+	MessageCacheSize        string `json:"msgcachesize"`
+	RRSetCacheSize          string `json:"rrsetcachesize"`
+	QnameMinimisation       string `json:"qnamemin"`
+	QnameMinimisationStrict string `json:"qnameminstrict"`
+	PrivateDomain           string `json:"privatedomain"`
+	InsecureDomain          string `json:"insecuredomain"`
+}
+
+type ForwardingSet struct {
+	Enabled string `json:"enabled"`
+	Mode    string `json:"mode"`
+}
+
+// Settings operations
+
+func (c *Controller) UnboundGetSettings(ctx context.Context) (*SettingsGetResponse, error) {
+	return api.Call(c.Client(), ctx, api.RPCOpts{BaseEndpoint: SettingsOpts.GetEndpoint, Method: "GET"}, &SettingsGetResponse{})
+}
+
+// UnboundSetSettings saves the settings without reconfiguring unbound.
+func (c *Controller) UnboundSetSettings(ctx context.Context, settings SettingsSetRequest) (*api.ActionResult, error) {
+	return api.Action(c.Client(), ctx, SettingsOpts.UpdateEndpoint, map[string]any{SettingsOpts.Monad: settings})
+}
+
+func (c *Controller) UnboundReconfigure(ctx context.Context) (*api.ActionResult, error) {
+	return api.Reconfigure(c.Client(), ctx, unboundReconfigureEndpoint)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/acmeclient"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/cron"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/service/dnsmasq"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/firewall"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/firmware"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/gateway"
@@ -290,6 +291,7 @@ func (p *opnsenseProvider) Configure(ctx context.Context, req provider.Configure
 func (p *opnsenseProvider) Resources(ctx context.Context) []func() resource.Resource {
 	controllers := [][]func() resource.Resource{
 		diagnostics.Resources(ctx),
+		dnsmasq.Resources(ctx),
 		firewall.Resources(ctx),
 		gateway.Resources(ctx),
 		haproxy.Resources(ctx),
@@ -316,6 +318,7 @@ func (p *opnsenseProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *opnsenseProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	controllers := [][]func() datasource.DataSource{
 		diagnostics.DataSources(ctx),
+		dnsmasq.DataSources(ctx),
 		firewall.DataSources(ctx),
 		gateway.DataSources(ctx),
 		haproxy.DataSources(ctx),
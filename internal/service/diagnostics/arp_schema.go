@@ -0,0 +1,117 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type arpDataSourceModel struct {
+	Entries types.List `tfsdk:"entries"`
+}
+
+type arpEntryModel struct {
+	IP                   types.String `tfsdk:"ip"`
+	MAC                  types.String `tfsdk:"mac"`
+	Interface            types.String `tfsdk:"interface"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	Hostname             types.String `tfsdk:"hostname"`
+	Manufacturer         types.String `tfsdk:"manufacturer"`
+	Permanent            types.Bool   `tfsdk:"permanent"`
+	Expires              types.Int64  `tfsdk:"expires"`
+}
+
+var arpEntryAttrTypes = map[string]attr.Type{
+	"ip":                    types.StringType,
+	"mac":                   types.StringType,
+	"interface":             types.StringType,
+	"interface_description": types.StringType,
+	"hostname":              types.StringType,
+	"manufacturer":          types.StringType,
+	"permanent":             types.BoolType,
+	"expires":               types.Int64Type,
+}
+
+func arpDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ARP can be used to get the IPv4 address resolution table of OPNsense.",
+
+		Attributes: map[string]schema.Attribute{
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all entries in the ARP table.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "IPv4 address of the neighbour.",
+							Computed:            true,
+						},
+						"mac": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the neighbour.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Device the neighbour was seen on, e.g. `vtnet1`.",
+							Computed:            true,
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the interface the neighbour was seen on.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the neighbour, resolved via DNS or DHCP leases.",
+							Computed:            true,
+						},
+						"manufacturer": schema.StringAttribute{
+							MarkdownDescription: "Manufacturer derived from the MAC address prefix.",
+							Computed:            true,
+						},
+						"permanent": schema.BoolAttribute{
+							MarkdownDescription: "Whether the entry is static.",
+							Computed:            true,
+						},
+						"expires": schema.Int64Attribute{
+							MarkdownDescription: "Seconds until the entry expires. `-1` for permanent entries.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertArpStructToSchema(d []diagnostics.ArpEntry) (*arpDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	entries := []arpEntryModel{}
+	for _, elem := range d {
+		expires := elem.Expires
+		if elem.Permanent {
+			expires = -1
+		}
+
+		entries = append(entries, arpEntryModel{
+			IP:                   types.StringValue(elem.IP),
+			MAC:                  types.StringValue(elem.MAC),
+			Interface:            types.StringValue(elem.Interface),
+			InterfaceDescription: types.StringValue(elem.InterfaceDescription),
+			Hostname:             types.StringValue(elem.Hostname),
+			Manufacturer:         types.StringValue(elem.Manufacturer),
+			Permanent:            types.BoolValue(elem.Permanent),
+			Expires:              types.Int64Value(expires),
+		})
+	}
+
+	v, _ := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(arpEntryAttrTypes),
+		entries,
+	)
+
+	return &arpDataSourceModel{
+		Entries: v,
+	}, nil
+}
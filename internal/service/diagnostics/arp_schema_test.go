@@ -0,0 +1,85 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertArpStructToSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []diagnostics.ArpEntry
+		expected []arpEntryModel
+	}{
+		{
+			name:     "empty",
+			input:    []diagnostics.ArpEntry{},
+			expected: []arpEntryModel{},
+		},
+		{
+			name: "dynamic_entry",
+			input: []diagnostics.ArpEntry{
+				{
+					IP:                   "192.168.1.10",
+					MAC:                  "00:00:5e:00:53:01",
+					Interface:            "igb1",
+					InterfaceDescription: "LAN",
+					Hostname:             "printer.lan",
+					Manufacturer:         "ICANN, IANA Department",
+					Expires:              1180,
+				},
+			},
+			expected: []arpEntryModel{
+				{
+					IP:                   types.StringValue("192.168.1.10"),
+					MAC:                  types.StringValue("00:00:5e:00:53:01"),
+					Interface:            types.StringValue("igb1"),
+					InterfaceDescription: types.StringValue("LAN"),
+					Hostname:             types.StringValue("printer.lan"),
+					Manufacturer:         types.StringValue("ICANN, IANA Department"),
+					Permanent:            types.BoolValue(false),
+					Expires:              types.Int64Value(1180),
+				},
+			},
+		},
+		{
+			name: "permanent_entry_does_not_expire",
+			input: []diagnostics.ArpEntry{
+				{
+					IP:        "192.168.1.1",
+					MAC:       "00:00:5e:00:53:02",
+					Interface: "igb1",
+					Permanent: true,
+					Expires:   0,
+				},
+			},
+			expected: []arpEntryModel{
+				{
+					IP:                   types.StringValue("192.168.1.1"),
+					MAC:                  types.StringValue("00:00:5e:00:53:02"),
+					Interface:            types.StringValue("igb1"),
+					InterfaceDescription: types.StringValue(""),
+					Hostname:             types.StringValue(""),
+					Manufacturer:         types.StringValue(""),
+					Permanent:            types.BoolValue(true),
+					Expires:              types.Int64Value(-1),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertArpStructToSchema(tt.input)
+			assert.NoError(t, err)
+
+			var entries []arpEntryModel
+			assert.False(t, result.Entries.ElementsAs(context.Background(), &entries, false).HasError())
+			assert.Equal(t, tt.expected, entries)
+		})
+	}
+}
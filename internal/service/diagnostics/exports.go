@@ -15,5 +15,8 @@ func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newInterfaceDataSource,
 		newInterfaceAllDataSource,
+		newArpDataSource,
+		newNdpDataSource,
+		newRoutesDataSource,
 	}
 }
@@ -3,7 +3,7 @@ package diagnostics
 import (
 	"context"
 
-	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,10 +29,10 @@ func interfaceAllDataSourceSchema() schema.Schema {
 	}
 }
 
-func convertAllInterfaceConfigStructToSchema(d []diagnostics.Interface) (*interfaceAllDataSourceModel, error) {
+func convertAllInterfaceConfigStructToSchema(d []diagnostics.Interface, stats map[string]diagnostics.InterfaceStatistics) (*interfaceAllDataSourceModel, error) {
 	var interfaces []interfaceDataSourceModel
 	for _, iface := range d {
-		toSchema, err := convertInterfaceConfigStructToSchema(&iface)
+		toSchema, err := convertInterfaceConfigStructToSchema(&iface, statisticsForDevice(stats, iface.Device))
 		if err != nil {
 			return nil, err
 		}
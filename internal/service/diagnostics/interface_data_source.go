@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
 
@@ -66,8 +66,17 @@ func (d *interfaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	// Get traffic counters from OPNsense API. These are optional, so users without access to them can still
+	// read the interface.
+	stats, err := d.client.Diagnostics().GetInterfaceStatistics(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Interface Statistics Unavailable",
+			fmt.Sprintf("Unable to read interface statistics, statistics is set to null. Got error: %s", err))
+		stats = nil
+	}
+
 	// Convert OPNsense struct to TF schema
-	model, err := convertInterfaceConfigStructToSchema(resource)
+	model, err := convertInterfaceConfigStructToSchema(resource, statisticsForDevice(stats, resource.Device))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to read interface, got error: %s", err))
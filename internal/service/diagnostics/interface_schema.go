@@ -3,7 +3,7 @@ package diagnostics
 import (
 	"context"
 
-	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,6 +27,8 @@ type interfaceDataSourceModel struct {
 
 	Ipv4 types.List `tfsdk:"ipv4"`
 	Ipv6 types.List `tfsdk:"ipv6"`
+
+	Statistics types.Object `tfsdk:"statistics"`
 }
 
 type ipv4Model struct {
@@ -45,6 +47,16 @@ type ipv6Model struct {
 	Tentative  types.Bool   `tfsdk:"tentative"`
 }
 
+type statisticsModel struct {
+	BytesIn    types.Int64 `tfsdk:"bytes_in"`
+	BytesOut   types.Int64 `tfsdk:"bytes_out"`
+	PacketsIn  types.Int64 `tfsdk:"packets_in"`
+	PacketsOut types.Int64 `tfsdk:"packets_out"`
+	ErrorsIn   types.Int64 `tfsdk:"errors_in"`
+	ErrorsOut  types.Int64 `tfsdk:"errors_out"`
+	Collisions types.Int64 `tfsdk:"collisions"`
+}
+
 var interfaceAttrTypes = map[string]attr.Type{
 	"device":      types.StringType,
 	"media":       types.StringType,
@@ -78,6 +90,9 @@ var interfaceAttrTypes = map[string]attr.Type{
 			AttrTypes: ipv6AttrTypes,
 		},
 	},
+	"statistics": types.ObjectType{
+		AttrTypes: statisticsAttrTypes,
+	},
 }
 
 var ipv4AttrTypes = map[string]attr.Type{
@@ -96,6 +111,16 @@ var ipv6AttrTypes = map[string]attr.Type{
 	"tentative":  types.BoolType,
 }
 
+var statisticsAttrTypes = map[string]attr.Type{
+	"bytes_in":    types.Int64Type,
+	"bytes_out":   types.Int64Type,
+	"packets_in":  types.Int64Type,
+	"packets_out": types.Int64Type,
+	"errors_in":   types.Int64Type,
+	"errors_out":  types.Int64Type,
+	"collisions":  types.Int64Type,
+}
+
 func interfaceDataSourceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Interfaces can be used to get configurations of OPNsense interfaces.",
@@ -206,11 +231,45 @@ func interfaceDataSourceSchema() schema.Schema {
 						},
 					},
 				}},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Traffic counters of the interface since boot. Null if OPNsense reports no counters for the interface, or they could not be read.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"bytes_in": schema.Int64Attribute{
+						MarkdownDescription: "Number of bytes received.",
+						Computed:            true,
+					},
+					"bytes_out": schema.Int64Attribute{
+						MarkdownDescription: "Number of bytes transmitted.",
+						Computed:            true,
+					},
+					"packets_in": schema.Int64Attribute{
+						MarkdownDescription: "Number of packets received.",
+						Computed:            true,
+					},
+					"packets_out": schema.Int64Attribute{
+						MarkdownDescription: "Number of packets transmitted.",
+						Computed:            true,
+					},
+					"errors_in": schema.Int64Attribute{
+						MarkdownDescription: "Number of input errors.",
+						Computed:            true,
+					},
+					"errors_out": schema.Int64Attribute{
+						MarkdownDescription: "Number of output errors.",
+						Computed:            true,
+					},
+					"collisions": schema.Int64Attribute{
+						MarkdownDescription: "Number of collisions.",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
 
-func convertInterfaceConfigStructToSchema(d *diagnostics.Interface) (*interfaceDataSourceModel, error) {
+func convertInterfaceConfigStructToSchema(d *diagnostics.Interface, stats *diagnostics.InterfaceStatistics) (*interfaceDataSourceModel, error) {
 	model := &interfaceDataSourceModel{
 		Device:         types.StringValue(d.Device),
 		Media:          types.StringValue(d.Media),
@@ -261,5 +320,33 @@ func convertInterfaceConfigStructToSchema(d *diagnostics.Interface) (*interfaceD
 		ipv6s,
 	)
 
+	// Interfaces without counters (e.g. not yet up) have no statistics, which is not the same as zero traffic.
+	if stats == nil {
+		model.Statistics = types.ObjectNull(statisticsAttrTypes)
+		return model, nil
+	}
+
+	model.Statistics, _ = types.ObjectValueFrom(
+		context.Background(),
+		statisticsAttrTypes,
+		statisticsModel{
+			BytesIn:    types.Int64Value(stats.BytesIn),
+			BytesOut:   types.Int64Value(stats.BytesOut),
+			PacketsIn:  types.Int64Value(stats.PacketsIn),
+			PacketsOut: types.Int64Value(stats.PacketsOut),
+			ErrorsIn:   types.Int64Value(stats.ErrorsIn),
+			ErrorsOut:  types.Int64Value(stats.ErrorsOut),
+			Collisions: types.Int64Value(stats.Collisions),
+		},
+	)
+
 	return model, nil
 }
+
+// statisticsForDevice returns the counters of a single device, or nil if OPNsense did not report any.
+func statisticsForDevice(stats map[string]diagnostics.InterfaceStatistics, device string) *diagnostics.InterfaceStatistics {
+	if s, ok := stats[device]; ok {
+		return &s
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatisticsForDevice(t *testing.T) {
+	stats := map[string]diagnostics.InterfaceStatistics{
+		"igb0": {BytesIn: 100, BytesOut: 200},
+	}
+
+	assert.Equal(t, &diagnostics.InterfaceStatistics{BytesIn: 100, BytesOut: 200}, statisticsForDevice(stats, "igb0"))
+	assert.Nil(t, statisticsForDevice(stats, "igb1"))
+	assert.Nil(t, statisticsForDevice(nil, "igb0"))
+}
+
+func TestConvertInterfaceStatistics(t *testing.T) {
+	stats := &diagnostics.InterfaceStatistics{
+		BytesIn:    1024,
+		BytesOut:   2048,
+		PacketsIn:  10,
+		PacketsOut: 20,
+		ErrorsIn:   1,
+		ErrorsOut:  2,
+		Collisions: 3,
+	}
+
+	result, err := convertInterfaceConfigStructToSchema(&diagnostics.Interface{Device: "igb0"}, stats)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue("igb0"), result.Device)
+
+	var model statisticsModel
+	assert.False(t, result.Statistics.As(context.Background(), &model, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, statisticsModel{
+		BytesIn:    types.Int64Value(1024),
+		BytesOut:   types.Int64Value(2048),
+		PacketsIn:  types.Int64Value(10),
+		PacketsOut: types.Int64Value(20),
+		ErrorsIn:   types.Int64Value(1),
+		ErrorsOut:  types.Int64Value(2),
+		Collisions: types.Int64Value(3),
+	}, model)
+}
+
+func TestConvertInterfaceWithoutStatistics(t *testing.T) {
+	result, err := convertInterfaceConfigStructToSchema(&diagnostics.Interface{Device: "igb1"}, nil)
+	assert.NoError(t, err)
+
+	// Missing counters are not reported as zero traffic
+	assert.True(t, result.Statistics.IsNull())
+}
@@ -0,0 +1,98 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ndpDataSourceModel struct {
+	Entries types.List `tfsdk:"entries"`
+}
+
+type ndpEntryModel struct {
+	IP                   types.String `tfsdk:"ip"`
+	MAC                  types.String `tfsdk:"mac"`
+	Interface            types.String `tfsdk:"interface"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	Hostname             types.String `tfsdk:"hostname"`
+	Manufacturer         types.String `tfsdk:"manufacturer"`
+}
+
+var ndpEntryAttrTypes = map[string]attr.Type{
+	"ip":                    types.StringType,
+	"mac":                   types.StringType,
+	"interface":             types.StringType,
+	"interface_description": types.StringType,
+	"hostname":              types.StringType,
+	"manufacturer":          types.StringType,
+}
+
+func ndpDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "NDP can be used to get the IPv6 neighbour discovery table of OPNsense.",
+
+		Attributes: map[string]schema.Attribute{
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all entries in the NDP table.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "IPv6 address of the neighbour.",
+							Computed:            true,
+						},
+						"mac": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the neighbour.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Device the neighbour was seen on, e.g. `vtnet1`.",
+							Computed:            true,
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the interface the neighbour was seen on.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the neighbour, resolved via DNS or DHCP leases.",
+							Computed:            true,
+						},
+						"manufacturer": schema.StringAttribute{
+							MarkdownDescription: "Manufacturer derived from the MAC address prefix.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertNdpStructToSchema(d []diagnostics.NdpEntry) (*ndpDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	entries := []ndpEntryModel{}
+	for _, elem := range d {
+		entries = append(entries, ndpEntryModel{
+			IP:                   types.StringValue(elem.IP),
+			MAC:                  types.StringValue(elem.MAC),
+			Interface:            types.StringValue(elem.Interface),
+			InterfaceDescription: types.StringValue(elem.InterfaceDescription),
+			Hostname:             types.StringValue(elem.Hostname),
+			Manufacturer:         types.StringValue(elem.Manufacturer),
+		})
+	}
+
+	v, _ := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(ndpEntryAttrTypes),
+		entries,
+	)
+
+	return &ndpDataSourceModel{
+		Entries: v,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertNdpStructToSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []diagnostics.NdpEntry
+		expected []ndpEntryModel
+	}{
+		{
+			name:     "empty",
+			input:    []diagnostics.NdpEntry{},
+			expected: []ndpEntryModel{},
+		},
+		{
+			name: "entries",
+			input: []diagnostics.NdpEntry{
+				{
+					IP:                   "2001:db8::10",
+					MAC:                  "00:00:5e:00:53:01",
+					Interface:            "igb1",
+					InterfaceDescription: "LAN",
+					Hostname:             "printer.lan",
+					Manufacturer:         "ICANN, IANA Department",
+				},
+				{
+					IP:        "fe80::1%igb1",
+					MAC:       "00:00:5e:00:53:02",
+					Interface: "igb1",
+				},
+			},
+			expected: []ndpEntryModel{
+				{
+					IP:                   types.StringValue("2001:db8::10"),
+					MAC:                  types.StringValue("00:00:5e:00:53:01"),
+					Interface:            types.StringValue("igb1"),
+					InterfaceDescription: types.StringValue("LAN"),
+					Hostname:             types.StringValue("printer.lan"),
+					Manufacturer:         types.StringValue("ICANN, IANA Department"),
+				},
+				{
+					IP:                   types.StringValue("fe80::1%igb1"),
+					MAC:                  types.StringValue("00:00:5e:00:53:02"),
+					Interface:            types.StringValue("igb1"),
+					InterfaceDescription: types.StringValue(""),
+					Hostname:             types.StringValue(""),
+					Manufacturer:         types.StringValue(""),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertNdpStructToSchema(tt.input)
+			assert.NoError(t, err)
+
+			var entries []ndpEntryModel
+			assert.False(t, result.Entries.ElementsAs(context.Background(), &entries, false).HasError())
+			assert.Equal(t, tt.expected, entries)
+		})
+	}
+}
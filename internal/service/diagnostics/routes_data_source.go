@@ -0,0 +1,79 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &routesDataSource{}
+var _ datasource.DataSourceWithConfigure = &routesDataSource{}
+
+func newRoutesDataSource() datasource.DataSource {
+	return &routesDataSource{}
+}
+
+// routesDataSource defines the data source implementation.
+type routesDataSource struct {
+	client opnsense.Client
+}
+
+func (d *routesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diagnostics_routes"
+}
+
+func (d *routesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = routesDataSourceSchema()
+}
+
+func (d *routesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *routesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *routesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get routes from OPNsense API
+	resources, err := d.client.Diagnostics().GetRoutes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read routing table, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	model, err := convertRoutesStructToSchema(resources)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read routing table, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
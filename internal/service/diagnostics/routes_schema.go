@@ -0,0 +1,106 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type routesDataSourceModel struct {
+	Routes types.List `tfsdk:"routes"`
+}
+
+type routeEntryModel struct {
+	Destination          types.String `tfsdk:"destination"`
+	Gateway              types.String `tfsdk:"gateway"`
+	Flags                types.String `tfsdk:"flags"`
+	Interface            types.String `tfsdk:"interface"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	MTU                  types.Int64  `tfsdk:"mtu"`
+	Protocol             types.String `tfsdk:"protocol"`
+}
+
+var routeEntryAttrTypes = map[string]attr.Type{
+	"destination":           types.StringType,
+	"gateway":               types.StringType,
+	"flags":                 types.StringType,
+	"interface":             types.StringType,
+	"interface_description": types.StringType,
+	"mtu":                   types.Int64Type,
+	"protocol":              types.StringType,
+}
+
+func routesDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Routes can be used to get the kernel routing table of OPNsense.",
+
+		Attributes: map[string]schema.Attribute{
+			"routes": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all routes in the kernel routing table.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": schema.StringAttribute{
+							MarkdownDescription: "Destination network or host (e.g. `10.0.0.0/24` or `default`).",
+							Computed:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Next hop of the route. Directly connected routes report the link (e.g. `link#2`).",
+							Computed:            true,
+						},
+						"flags": schema.StringAttribute{
+							MarkdownDescription: "Route flags as reported by `netstat -r` (e.g. `UGS`).",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Device the route points out of, e.g. `vtnet0`.",
+							Computed:            true,
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the interface the route points out of.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the route.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol family of the route. One of `ipv4`, `ipv6`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertRoutesStructToSchema(d []diagnostics.Route) (*routesDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	routes := []routeEntryModel{}
+	for _, elem := range d {
+		routes = append(routes, routeEntryModel{
+			Destination:          types.StringValue(elem.Destination),
+			Gateway:              types.StringValue(elem.Gateway),
+			Flags:                types.StringValue(elem.Flags),
+			Interface:            types.StringValue(elem.Interface),
+			InterfaceDescription: types.StringValue(elem.InterfaceDescription),
+			MTU:                  tools.StringToInt64Null(elem.MTU),
+			Protocol:             types.StringValue(elem.Protocol),
+		})
+	}
+
+	v, _ := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(routeEntryAttrTypes),
+		routes,
+	)
+
+	return &routesDataSourceModel{
+		Routes: v,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertRoutesStructToSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []diagnostics.Route
+		expected []routeEntryModel
+	}{
+		{
+			name:     "empty",
+			input:    []diagnostics.Route{},
+			expected: []routeEntryModel{},
+		},
+		{
+			name: "routes",
+			input: []diagnostics.Route{
+				{
+					Destination:          "default",
+					Gateway:              "192.0.2.1",
+					Flags:                "UGS",
+					Interface:            "igb0",
+					InterfaceDescription: "WAN",
+					MTU:                  "1500",
+					Protocol:             "ipv4",
+				},
+				{
+					Destination: "2001:db8::/64",
+					Gateway:     "link#2",
+					Flags:       "U",
+					Interface:   "igb1",
+					Protocol:    "ipv6",
+				},
+			},
+			expected: []routeEntryModel{
+				{
+					Destination:          types.StringValue("default"),
+					Gateway:              types.StringValue("192.0.2.1"),
+					Flags:                types.StringValue("UGS"),
+					Interface:            types.StringValue("igb0"),
+					InterfaceDescription: types.StringValue("WAN"),
+					MTU:                  types.Int64Value(1500),
+					Protocol:             types.StringValue("ipv4"),
+				},
+				{
+					Destination:          types.StringValue("2001:db8::/64"),
+					Gateway:              types.StringValue("link#2"),
+					Flags:                types.StringValue("U"),
+					Interface:            types.StringValue("igb1"),
+					InterfaceDescription: types.StringValue(""),
+					MTU:                  types.Int64Null(),
+					Protocol:             types.StringValue("ipv6"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertRoutesStructToSchema(tt.input)
+			assert.NoError(t, err)
+
+			var routes []routeEntryModel
+			assert.False(t, result.Routes.ElementsAs(context.Background(), &routes, false).HasError())
+			assert.Equal(t, tt.expected, routes)
+		})
+	}
+}
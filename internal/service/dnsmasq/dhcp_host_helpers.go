@@ -0,0 +1,53 @@
+package dnsmasq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Static hosts share the host endpoint with host overrides; they are hosts with a hardware address or client identifier.
+func dhcpHostResponseToModel(id string, resp *dnsmasq.HostGetResponse) dnsmasqDHCPHostResourceModel {
+	host := resp.Host
+
+	return dnsmasqDHCPHostResourceModel{
+		Id:           types.StringValue(id),
+		Hostname:     types.StringValue(host.Host),
+		Domain:       types.StringValue(host.Domain),
+		IpAddress:    types.StringValue(selectedOptionKey(host.IP)),
+		MacAddresses: tools.StringSliceToSet(selectedOptionKeys(host.HwAddr)),
+		ClientId:     types.StringValue(host.ClientID),
+		Tags:         tools.StringSliceToSet(selectedOptionKeys(host.SetTag)),
+		LeaseTime:    types.Int64Value(tools.StringToInt64(host.LeaseTime)),
+		Description:  types.StringValue(host.Description),
+	}
+}
+
+func (m *dnsmasqDHCPHostResourceModel) toDHCPHost() dnsmasq.Host {
+	if m == nil {
+		return dnsmasq.Host{}
+	}
+
+	return dnsmasq.Host{
+		Host:        stringToAPIValue(m.Hostname),
+		Domain:      stringToAPIValue(m.Domain),
+		IP:          stringToAPIValue(m.IpAddress),
+		HwAddr:      strings.Join(tools.SetToStringSlice(m.MacAddresses), ","),
+		ClientID:    stringToAPIValue(m.ClientId),
+		SetTag:      strings.Join(tools.SetToStringSlice(m.Tags), ","),
+		LeaseTime:   int64ToAPIStringNegative(m.LeaseTime),
+		Description: stringToAPIValue(m.Description),
+	}
+}
+
+func fetchDHCPHostModel(ctx context.Context, controller *dnsmasq.Controller, id string) (dnsmasqDHCPHostResourceModel, error) {
+	resp, err := controller.DnsmasqGetHost(ctx, id)
+	if err != nil {
+		return dnsmasqDHCPHostResourceModel{}, err
+	}
+
+	return dhcpHostResponseToModel(id, resp), nil
+}
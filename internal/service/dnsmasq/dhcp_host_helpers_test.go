@@ -0,0 +1,77 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDHCPHostToDHCPHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    *dnsmasqDHCPHostResourceModel
+		expected dnsmasq.Host
+	}{
+		{
+			name: "mac_addresses_and_tags",
+			model: &dnsmasqDHCPHostResourceModel{
+				Id:           types.StringValue("host-id"),
+				Hostname:     types.StringValue("printer"),
+				Domain:       types.StringValue("example.internal"),
+				IpAddress:    types.StringValue("192.168.1.50"),
+				MacAddresses: tools.StringSliceToSet([]string{"00:00:5e:00:53:01", "00:00:5e:00:53:02"}),
+				ClientId:     types.StringValue(""),
+				Tags:         tools.StringSliceToSet([]string{"printers"}),
+				LeaseTime:    types.Int64Value(86400),
+				Description:  types.StringValue("office printer"),
+			},
+			expected: dnsmasq.Host{
+				Host:        "printer",
+				Domain:      "example.internal",
+				IP:          "192.168.1.50",
+				HwAddr:      "00:00:5e:00:53:01,00:00:5e:00:53:02",
+				ClientID:    "",
+				SetTag:      "printers",
+				LeaseTime:   "86400",
+				Description: "office printer",
+			},
+		},
+		{
+			name: "client_id_and_default_lease_time",
+			model: &dnsmasqDHCPHostResourceModel{
+				Hostname:     types.StringValue("sensor"),
+				Domain:       types.StringValue(""),
+				IpAddress:    types.StringValue("2001:db8::50"),
+				MacAddresses: tools.StringSliceToSet([]string{}),
+				ClientId:     types.StringValue("00:01:00:01:2b:3c:4d:5e:00:00:5e:00:53:03"),
+				Tags:         tools.StringSliceToSet([]string{}),
+				LeaseTime:    types.Int64Value(-1),
+				Description:  types.StringValue(""),
+			},
+			expected: dnsmasq.Host{
+				Host:        "sensor",
+				Domain:      "",
+				IP:          "2001:db8::50",
+				HwAddr:      "",
+				ClientID:    "00:01:00:01:2b:3c:4d:5e:00:00:5e:00:53:03",
+				SetTag:      "",
+				LeaseTime:   "",
+				Description: "",
+			},
+		},
+		{
+			name:     "nil",
+			model:    nil,
+			expected: dnsmasq.Host{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.model.toDHCPHost())
+		})
+	}
+}
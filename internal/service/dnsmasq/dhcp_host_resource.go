@@ -0,0 +1,224 @@
+package dnsmasq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &dnsmasqDHCPHostResource{}
+var _ resource.ResourceWithConfigure = &dnsmasqDHCPHostResource{}
+var _ resource.ResourceWithImportState = &dnsmasqDHCPHostResource{}
+var _ resource.ResourceWithValidateConfig = &dnsmasqDHCPHostResource{}
+
+func newDnsmasqDHCPHostResource() resource.Resource {
+	return &dnsmasqDHCPHostResource{}
+}
+
+type dnsmasqDHCPHostResource struct {
+	client opnsense.Client
+}
+
+func (r *dnsmasqDHCPHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsmasq_dhcp_host"
+}
+
+func (r *dnsmasqDHCPHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dnsmasqDHCPHostResourceSchema()
+}
+
+func (r *dnsmasqDHCPHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *dnsmasqDHCPHostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *dnsmasqDHCPHostResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.MacAddresses.IsUnknown() || data.ClientId.IsUnknown() {
+		return
+	}
+
+	// Without either identifier the entry is a plain host override and never matches a DHCP client
+	hasMAC := !data.MacAddresses.IsNull() && len(data.MacAddresses.Elements()) > 0
+	hasClientId := !data.ClientId.IsNull() && data.ClientId.ValueString() != ""
+	if !hasMAC && !hasClientId {
+		resp.Diagnostics.AddAttributeError(path.Root("mac_addresses"), "Missing Attribute Configuration",
+			"Either mac_addresses or client_id must be set to identify the client.")
+	}
+}
+
+func (r *dnsmasqDHCPHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *dnsmasqDHCPHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqAddHost(ctx, data.toDHCPHost())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dnsmasq DHCP static host, got error: %s", err))
+		return
+	}
+
+	if result == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create dnsmasq DHCP static host: empty response received from API.")
+		return
+	}
+
+	if result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("create Dnsmasq DHCP static host", result))
+		return
+	}
+
+	if result.UUID == "" {
+		resp.Diagnostics.AddError("Client Error", "Unable to create dnsmasq DHCP static host: API response did not include a UUID.")
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	model, err := fetchDHCPHostModel(ctx, r.client.Dnsmasq(), result.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP static host after create, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created dnsmasq DHCP static host", map[string]any{"id": result.UUID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *dnsmasqDHCPHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model, err := fetchDHCPHostModel(ctx, r.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("dnsmasq DHCP static host %s not present in remote, removing from state", data.Id.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP static host, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *dnsmasqDHCPHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		resp.Diagnostics.AddError("Client Error", "Dnsmasq DHCP static host update requires a valid id.")
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqEditHost(ctx, data.Id.ValueString(), data.toDHCPHost())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dnsmasq DHCP static host, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("update Dnsmasq DHCP static host", result))
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	model, err := fetchDHCPHostModel(ctx, r.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP static host after update, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *dnsmasqDHCPHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqDeleteHost(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dnsmasq DHCP static host, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("delete Dnsmasq DHCP static host", result))
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+}
+
+func (r *dnsmasqDHCPHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,162 @@
+package dnsmasq
+
+import (
+	"regexp"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var macAddressRegex = regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`)
+
+// dnsmasqDHCPHostResourceModel describes the Terraform model for a DHCP static host.
+type dnsmasqDHCPHostResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Domain       types.String `tfsdk:"domain"`
+	IpAddress    types.String `tfsdk:"ip_address"`
+	MacAddresses types.Set    `tfsdk:"mac_addresses"`
+	ClientId     types.String `tfsdk:"client_id"`
+	Tags         types.Set    `tfsdk:"tags"`
+	LeaseTime    types.Int64  `tfsdk:"lease_time"`
+	Description  types.String `tfsdk:"description"`
+}
+
+func dnsmasqDHCPHostResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage a Dnsmasq DHCP static host, which always hands the same address to a client identified by MAC address or client identifier. Dnsmasq is reconfigured after every change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the static host.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to offer to the client, without the domain part.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the host, e.g. `example.com`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "Address handed to the client.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"mac_addresses": schema.SetAttribute{
+				MarkdownDescription: "MAC addresses of the client, e.g. `00:11:22:33:44:55`. Either this or `client_id` must be set. Defaults to `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(macAddressRegex, "must be a MAC address in the form 00:11:22:33:44:55"),
+					),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier (or DUID for IPv6) of the client. Either this or `mac_addresses` must be set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "IDs of `opnsense_dnsmasq_dhcp_tag` resources set on the client, so tagged DHCP options apply to it. Defaults to `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds. Set to `-1` to use the lease time of the range. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.AtLeast(120),
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func dnsmasqDHCPHostDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read a Dnsmasq DHCP static host.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the static host.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"hostname": dschema.StringAttribute{
+				MarkdownDescription: "Hostname offered to the client.",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain of the host.",
+				Computed:            true,
+			},
+			"ip_address": dschema.StringAttribute{
+				MarkdownDescription: "Address handed to the client.",
+				Computed:            true,
+			},
+			"mac_addresses": dschema.SetAttribute{
+				MarkdownDescription: "MAC addresses of the client.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"client_id": dschema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier of the client.",
+				Computed:            true,
+			},
+			"tags": dschema.SetAttribute{
+				MarkdownDescription: "IDs of the DHCP tags set on the client.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"lease_time": dschema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds, or `-1` for the lease time of the range.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the static host.",
+				Computed:            true,
+			},
+		},
+	}
+}
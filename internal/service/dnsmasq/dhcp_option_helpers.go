@@ -0,0 +1,48 @@
+package dnsmasq
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dhcpOptionResponseToModel(id string, resp *dnsmasq.OptionGetResponse) dnsmasqDHCPOptionResourceModel {
+	option := resp.Option
+
+	return dnsmasqDHCPOptionResourceModel{
+		Id:          types.StringValue(id),
+		Tag:         types.StringValue(selectedOptionKey(option.Tag)),
+		Interface:   types.StringValue(selectedOptionKey(option.Interface)),
+		Option:      types.StringValue(selectedOptionKey(option.Option)),
+		Value:       types.StringValue(option.Value),
+		Force:       types.BoolValue(tools.StringToBool(option.Force)),
+		Description: types.StringValue(option.Description),
+	}
+}
+
+func (m *dnsmasqDHCPOptionResourceModel) toDHCPOption() dnsmasq.Option {
+	if m == nil {
+		return dnsmasq.Option{}
+	}
+
+	return dnsmasq.Option{
+		Type:        "set",
+		Tag:         stringToAPIValue(m.Tag),
+		Interface:   stringToAPIValue(m.Interface),
+		Option:      stringToAPIValue(m.Option),
+		Value:       stringToAPIValue(m.Value),
+		Force:       boolToAPIString(m.Force),
+		Description: stringToAPIValue(m.Description),
+	}
+}
+
+func fetchDHCPOptionModel(ctx context.Context, controller *dnsmasq.Controller, id string) (dnsmasqDHCPOptionResourceModel, error) {
+	resp, err := controller.DnsmasqGetOption(ctx, id)
+	if err != nil {
+		return dnsmasqDHCPOptionResourceModel{}, err
+	}
+
+	return dhcpOptionResponseToModel(id, resp), nil
+}
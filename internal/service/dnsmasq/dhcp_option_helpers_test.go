@@ -0,0 +1,70 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDHCPOptionToDHCPOption(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    *dnsmasqDHCPOptionResourceModel
+		expected dnsmasq.Option
+	}{
+		{
+			name: "tagged_option",
+			model: &dnsmasqDHCPOptionResourceModel{
+				Id:          types.StringValue("option-id"),
+				Tag:         types.StringValue("tag-uuid"),
+				Interface:   types.StringValue(""),
+				Option:      types.StringValue("6"),
+				Value:       types.StringValue("192.168.1.53"),
+				Force:       types.BoolValue(true),
+				Description: types.StringValue("DNS server"),
+			},
+			expected: dnsmasq.Option{
+				Type:        "set",
+				Tag:         "tag-uuid",
+				Interface:   "",
+				Option:      "6",
+				Value:       "192.168.1.53",
+				Force:       "1",
+				Description: "DNS server",
+			},
+		},
+		{
+			name: "interface_option",
+			model: &dnsmasqDHCPOptionResourceModel{
+				Tag:         types.StringValue(""),
+				Interface:   types.StringValue("lan"),
+				Option:      types.StringValue("42"),
+				Value:       types.StringValue("192.168.1.1"),
+				Force:       types.BoolNull(),
+				Description: types.StringNull(),
+			},
+			expected: dnsmasq.Option{
+				Type:        "set",
+				Tag:         "",
+				Interface:   "lan",
+				Option:      "42",
+				Value:       "192.168.1.1",
+				Force:       "0",
+				Description: "",
+			},
+		},
+		{
+			name:     "nil",
+			model:    nil,
+			expected: dnsmasq.Option{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.model.toDHCPOption())
+		})
+	}
+}
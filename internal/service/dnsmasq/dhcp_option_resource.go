@@ -0,0 +1,201 @@
+package dnsmasq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &dnsmasqDHCPOptionResource{}
+var _ resource.ResourceWithConfigure = &dnsmasqDHCPOptionResource{}
+var _ resource.ResourceWithImportState = &dnsmasqDHCPOptionResource{}
+
+func newDnsmasqDHCPOptionResource() resource.Resource {
+	return &dnsmasqDHCPOptionResource{}
+}
+
+type dnsmasqDHCPOptionResource struct {
+	client opnsense.Client
+}
+
+func (r *dnsmasqDHCPOptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsmasq_dhcp_option"
+}
+
+func (r *dnsmasqDHCPOptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dnsmasqDHCPOptionResourceSchema()
+}
+
+func (r *dnsmasqDHCPOptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *dnsmasqDHCPOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *dnsmasqDHCPOptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqAddOption(ctx, data.toDHCPOption())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dnsmasq DHCP option, got error: %s", err))
+		return
+	}
+
+	if result == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create dnsmasq DHCP option: empty response received from API.")
+		return
+	}
+
+	if result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("create Dnsmasq DHCP option", result))
+		return
+	}
+
+	if result.UUID == "" {
+		resp.Diagnostics.AddError("Client Error", "Unable to create dnsmasq DHCP option: API response did not include a UUID.")
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	model, err := fetchDHCPOptionModel(ctx, r.client.Dnsmasq(), result.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP option after create, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created dnsmasq DHCP option", map[string]any{"id": result.UUID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *dnsmasqDHCPOptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model, err := fetchDHCPOptionModel(ctx, r.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("dnsmasq DHCP option %s not present in remote, removing from state", data.Id.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP option, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *dnsmasqDHCPOptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		resp.Diagnostics.AddError("Client Error", "Dnsmasq DHCP option update requires a valid id.")
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqEditOption(ctx, data.Id.ValueString(), data.toDHCPOption())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dnsmasq DHCP option, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("update Dnsmasq DHCP option", result))
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	model, err := fetchDHCPOptionModel(ctx, r.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP option after update, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *dnsmasqDHCPOptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqDeleteOption(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dnsmasq DHCP option, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("delete Dnsmasq DHCP option", result))
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+}
+
+func (r *dnsmasqDHCPOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
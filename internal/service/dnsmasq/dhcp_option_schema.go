@@ -0,0 +1,115 @@
+package dnsmasq
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsmasqDHCPOptionResourceModel describes the Terraform model for a DHCP option.
+type dnsmasqDHCPOptionResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Tag         types.String `tfsdk:"tag"`
+	Interface   types.String `tfsdk:"interface"`
+	Option      types.String `tfsdk:"option"`
+	Value       types.String `tfsdk:"value"`
+	Force       types.Bool   `tfsdk:"force"`
+	Description types.String `tfsdk:"description"`
+}
+
+func dnsmasqDHCPOptionResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage a Dnsmasq DHCP option, which is sent to clients that match a tag, an interface, or to all clients. Dnsmasq is reconfigured after every change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the DHCP option.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "ID of the `opnsense_dnsmasq_dhcp_tag` clients must have to receive the option. Leave empty to match all clients. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface clients must be on to receive the option, e.g. `lan`. Leave empty to match all interfaces. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"option": schema.StringAttribute{
+				MarkdownDescription: "Option number, e.g. `6`, or name, e.g. `dns-server`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+|[a-z0-9-]+)$`), "must be an option number or name"),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the option. Separate multiple values with a comma, e.g. `192.168.1.1,192.168.1.2`.",
+				Required:            true,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Send the option even when the client does not request it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func dnsmasqDHCPOptionDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read a Dnsmasq DHCP option.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the DHCP option.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tag": dschema.StringAttribute{
+				MarkdownDescription: "ID of the DHCP tag clients must have to receive the option.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "Interface clients must be on to receive the option.",
+				Computed:            true,
+			},
+			"option": dschema.StringAttribute{
+				MarkdownDescription: "Option number or name.",
+				Computed:            true,
+			},
+			"value": dschema.StringAttribute{
+				MarkdownDescription: "Value of the option.",
+				Computed:            true,
+			},
+			"force": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the option is sent even when the client does not request it.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the DHCP option.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package dnsmasq
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dhcpRangeResponseToModel(id string, resp *dnsmasq.RangeGetResponse) dnsmasqDHCPRangeResourceModel {
+	dhcpRange := resp.Range
+
+	return dnsmasqDHCPRangeResourceModel{
+		Id:           types.StringValue(id),
+		Interface:    types.StringValue(selectedOptionKey(dhcpRange.Interface)),
+		StartAddress: types.StringValue(dhcpRange.StartAddr),
+		EndAddress:   types.StringValue(dhcpRange.EndAddr),
+		LeaseTime:    types.Int64Value(tools.StringToInt64(dhcpRange.LeaseTime)),
+		RAMode:       tools.StringSliceToSet(selectedOptionKeys(dhcpRange.RAMode)),
+		Constructor:  types.StringValue(selectedOptionKey(dhcpRange.Constructor)),
+		Description:  types.StringValue(dhcpRange.Description),
+	}
+}
+
+func (m *dnsmasqDHCPRangeResourceModel) toDHCPRange() dnsmasq.Range {
+	if m == nil {
+		return dnsmasq.Range{}
+	}
+
+	return dnsmasq.Range{
+		Interface:   stringToAPIValue(m.Interface),
+		StartAddr:   stringToAPIValue(m.StartAddress),
+		EndAddr:     stringToAPIValue(m.EndAddress),
+		LeaseTime:   int64ToAPIStringNegative(m.LeaseTime),
+		RAMode:      strings.Join(tools.SetToStringSlice(m.RAMode), ","),
+		Constructor: stringToAPIValue(m.Constructor),
+		Description: stringToAPIValue(m.Description),
+	}
+}
+
+func fetchDHCPRangeModel(ctx context.Context, controller *dnsmasq.Controller, id string) (dnsmasqDHCPRangeResourceModel, error) {
+	resp, err := controller.DnsmasqGetRange(ctx, id)
+	if err != nil {
+		return dnsmasqDHCPRangeResourceModel{}, err
+	}
+
+	return dhcpRangeResponseToModel(id, resp), nil
+}
+
+// isIPv6 reports whether the value is a valid IPv6 address.
+func isIPv6(value string) bool {
+	ip := net.ParseIP(value)
+	return ip != nil && ip.To4() == nil
+}
@@ -0,0 +1,93 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDHCPRangeToDHCPRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    *dnsmasqDHCPRangeResourceModel
+		expected dnsmasq.Range
+	}{
+		{
+			name: "ipv4_range",
+			model: &dnsmasqDHCPRangeResourceModel{
+				Id:           types.StringValue("range-id"),
+				Interface:    types.StringValue("lan"),
+				StartAddress: types.StringValue("192.168.1.100"),
+				EndAddress:   types.StringValue("192.168.1.199"),
+				LeaseTime:    types.Int64Value(-1),
+				RAMode:       tools.StringSliceToSet([]string{}),
+				Constructor:  types.StringValue(""),
+				Description:  types.StringValue("clients"),
+			},
+			expected: dnsmasq.Range{
+				Interface:   "lan",
+				StartAddr:   "192.168.1.100",
+				EndAddr:     "192.168.1.199",
+				LeaseTime:   "",
+				RAMode:      "",
+				Constructor: "",
+				Description: "clients",
+			},
+		},
+		{
+			name: "ipv6_range_with_router_advertisements",
+			model: &dnsmasqDHCPRangeResourceModel{
+				Interface:    types.StringValue("lan"),
+				StartAddress: types.StringValue("::1000"),
+				EndAddress:   types.StringValue("::1fff"),
+				LeaseTime:    types.Int64Value(3600),
+				RAMode:       tools.StringSliceToSet([]string{"ra-names", "slaac"}),
+				Constructor:  types.StringValue("lan"),
+				Description:  types.StringValue(""),
+			},
+			expected: dnsmasq.Range{
+				Interface:   "lan",
+				StartAddr:   "::1000",
+				EndAddr:     "::1fff",
+				LeaseTime:   "3600",
+				RAMode:      "ra-names,slaac",
+				Constructor: "lan",
+				Description: "",
+			},
+		},
+		{
+			name:     "nil",
+			model:    nil,
+			expected: dnsmasq.Range{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.model.toDHCPRange())
+		})
+	}
+}
+
+func TestIsIPv6(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "192.168.1.100", expected: false},
+		{value: "2001:db8::100", expected: true},
+		{value: "::1000", expected: true},
+		{value: "::ffff:192.168.1.100", expected: false},
+		{value: "", expected: false},
+		{value: "not-an-address", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isIPv6(tt.value))
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package dnsmasq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &dnsmasqDHCPRangeResource{}
+var _ resource.ResourceWithConfigure = &dnsmasqDHCPRangeResource{}
+var _ resource.ResourceWithImportState = &dnsmasqDHCPRangeResource{}
+var _ resource.ResourceWithValidateConfig = &dnsmasqDHCPRangeResource{}
+
+func newDnsmasqDHCPRangeResource() resource.Resource {
+	return &dnsmasqDHCPRangeResource{}
+}
+
+type dnsmasqDHCPRangeResource struct {
+	client opnsense.Client
+}
+
+func (r *dnsmasqDHCPRangeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsmasq_dhcp_range"
+}
+
+func (r *dnsmasqDHCPRangeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dnsmasqDHCPRangeResourceSchema()
+}
+
+func (r *dnsmasqDHCPRangeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *dnsmasqDHCPRangeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *dnsmasqDHCPRangeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.StartAddress.IsUnknown() || data.EndAddress.IsUnknown() {
+		return
+	}
+
+	ipv6 := isIPv6(data.StartAddress.ValueString())
+	if ipv6 != isIPv6(data.EndAddress.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("end_address"), "Invalid Attribute Configuration",
+			"start_address and end_address must be of the same address family.")
+		return
+	}
+
+	if ipv6 {
+		return
+	}
+
+	if !data.RAMode.IsNull() && !data.RAMode.IsUnknown() && len(data.RAMode.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("ra_mode"), "Invalid Attribute Configuration",
+			"ra_mode can only be set on IPv6 ranges.")
+	}
+
+	if !data.Constructor.IsNull() && !data.Constructor.IsUnknown() && data.Constructor.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("constructor"), "Invalid Attribute Configuration",
+			"constructor can only be set on IPv6 ranges.")
+	}
+}
+
+func (r *dnsmasqDHCPRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *dnsmasqDHCPRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqAddRange(ctx, data.toDHCPRange())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dnsmasq DHCP range, got error: %s", err))
+		return
+	}
+
+	if result == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create dnsmasq DHCP range: empty response received from API.")
+		return
+	}
+
+	if result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("create Dnsmasq DHCP range", result))
+		return
+	}
+
+	if result.UUID == "" {
+		resp.Diagnostics.AddError("Client Error", "Unable to create dnsmasq DHCP range: API response did not include a UUID.")
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	model, err := fetchDHCPRangeModel(ctx, r.client.Dnsmasq(), result.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP range after create, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created dnsmasq DHCP range", map[string]any{"id": result.UUID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *dnsmasqDHCPRangeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model, err := fetchDHCPRangeModel(ctx, r.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("dnsmasq DHCP range %s not present in remote, removing from state", data.Id.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP range, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *dnsmasqDHCPRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		resp.Diagnostics.AddError("Client Error", "Dnsmasq DHCP range update requires a valid id.")
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqEditRange(ctx, data.Id.ValueString(), data.toDHCPRange())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dnsmasq DHCP range, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("update Dnsmasq DHCP range", result))
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	model, err := fetchDHCPRangeModel(ctx, r.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq DHCP range after update, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *dnsmasqDHCPRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *dnsmasqDHCPRangeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil || data.Id.IsNull() || data.Id.IsUnknown() {
+		return
+	}
+
+	result, err := r.client.Dnsmasq().DnsmasqDeleteRange(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dnsmasq DHCP range, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error", formatActionResultFailure("delete Dnsmasq DHCP range", result))
+		return
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+}
+
+func (r *dnsmasqDHCPRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
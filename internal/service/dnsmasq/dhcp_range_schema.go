@@ -0,0 +1,146 @@
+package dnsmasq
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsmasqDHCPRangeResourceModel describes the Terraform model for a DHCP range.
+type dnsmasqDHCPRangeResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Interface    types.String `tfsdk:"interface"`
+	StartAddress types.String `tfsdk:"start_address"`
+	EndAddress   types.String `tfsdk:"end_address"`
+	LeaseTime    types.Int64  `tfsdk:"lease_time"`
+	RAMode       types.Set    `tfsdk:"ra_mode"`
+	Constructor  types.String `tfsdk:"constructor"`
+	Description  types.String `tfsdk:"description"`
+}
+
+func dnsmasqDHCPRangeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage a Dnsmasq DHCP range, which hands out addresses to clients on an interface. Dnsmasq is reconfigured after every change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the DHCP range.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the range is served on, e.g. `lan`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_address": schema.StringAttribute{
+				MarkdownDescription: "First address of the range. When `constructor` is set, only the host part is used, e.g. `::1000`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"end_address": schema.StringAttribute{
+				MarkdownDescription: "Last address of the range. Must be of the same address family as `start_address`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds. Set to `-1` to use the Dnsmasq default of one hour. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.AtLeast(120),
+					),
+				},
+			},
+			"ra_mode": schema.SetAttribute{
+				MarkdownDescription: "Router advertisement modes for IPv6 ranges. Must be one of `ra-only`, `slaac`, `ra-names`, `ra-stateless`, `ra-advrouter`, `off-link`. Defaults to `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("ra-only", "slaac", "ra-names", "ra-stateless", "ra-advrouter", "off-link"),
+					),
+				},
+			},
+			"constructor": schema.StringAttribute{
+				MarkdownDescription: "Interface whose IPv6 prefix is used to build the range, for prefixes that are delegated dynamically. Only valid for IPv6 ranges. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func dnsmasqDHCPRangeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read a Dnsmasq DHCP range.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the DHCP range.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "Interface the range is served on.",
+				Computed:            true,
+			},
+			"start_address": dschema.StringAttribute{
+				MarkdownDescription: "First address of the range.",
+				Computed:            true,
+			},
+			"end_address": dschema.StringAttribute{
+				MarkdownDescription: "Last address of the range.",
+				Computed:            true,
+			},
+			"lease_time": dschema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds, or `-1` for the Dnsmasq default.",
+				Computed:            true,
+			},
+			"ra_mode": dschema.SetAttribute{
+				MarkdownDescription: "Router advertisement modes for IPv6 ranges.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"constructor": dschema.StringAttribute{
+				MarkdownDescription: "Interface whose IPv6 prefix is used to build the range.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the DHCP range.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package dnsmasq
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dhcpTagResponseToModel(id string, resp *dnsmasq.TagGetResponse) dnsmasqDHCPTagResourceModel {
+	return dnsmasqDHCPTagResourceModel{
+		Id:   types.StringValue(id),
+		Name: types.StringValue(resp.Tag.Tag),
+	}
+}
+
+func (m *dnsmasqDHCPTagResourceModel) toDHCPTag() dnsmasq.Tag {
+	if m == nil {
+		return dnsmasq.Tag{}
+	}
+
+	return dnsmasq.Tag{
+		Tag: stringToAPIValue(m.Name),
+	}
+}
+
+func fetchDHCPTagModel(ctx context.Context, controller *dnsmasq.Controller, id string) (dnsmasqDHCPTagResourceModel, error) {
+	resp, err := controller.DnsmasqGetTag(ctx, id)
+	if err != nil {
+		return dnsmasqDHCPTagResourceModel{}, err
+	}
+
+	return dhcpTagResponseToModel(id, resp), nil
+}
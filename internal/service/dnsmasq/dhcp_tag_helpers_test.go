@@ -0,0 +1,20 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDHCPTagToDHCPTag(t *testing.T) {
+	model := &dnsmasqDHCPTagResourceModel{
+		Id:   types.StringValue("tag-id"),
+		Name: types.StringValue("printers"),
+	}
+	assert.Equal(t, dnsmasq.Tag{Tag: "printers"}, model.toDHCPTag())
+
+	var empty *dnsmasqDHCPTagResourceModel
+	assert.Equal(t, dnsmasq.Tag{}, empty.toDHCPTag())
+}
@@ -0,0 +1,60 @@
+package dnsmasq
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsmasqDHCPTagResourceModel describes the Terraform model for a DHCP tag.
+type dnsmasqDHCPTagResourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func dnsmasqDHCPTagResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage a Dnsmasq DHCP tag. Tags are set on static hosts and select which DHCP options are sent to them. Dnsmasq is reconfigured after every change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the tag.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag. May only contain letters, digits, `_` and `-`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "may only contain letters, digits, _ and -"),
+				},
+			},
+		},
+	}
+}
+
+func dnsmasqDHCPTagDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read a Dnsmasq DHCP tag.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the tag.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the tag.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package dnsmasq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var _ datasource.DataSource = &dnsmasqDomainOverrideDataSource{}
+var _ datasource.DataSourceWithConfigure = &dnsmasqDomainOverrideDataSource{}
+
+func newDnsmasqDomainOverrideDataSource() datasource.DataSource {
+	return &dnsmasqDomainOverrideDataSource{}
+}
+
+type dnsmasqDomainOverrideDataSource struct {
+	client opnsense.Client
+}
+
+func (d *dnsmasqDomainOverrideDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsmasq_domain_override"
+}
+
+func (d *dnsmasqDomainOverrideDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dnsmasqDomainOverrideDataSourceSchema()
+}
+
+func (d *dnsmasqDomainOverrideDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *dnsmasqDomainOverrideDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *dnsmasqDomainOverrideResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil {
+		resp.Diagnostics.AddError("Client Error", "Failed to decode dnsmasq domain override data source configuration.")
+		return
+	}
+
+	if data.Id.IsUnknown() {
+		return
+	}
+
+	if data.Id.IsNull() || data.Id.ValueString() == "" {
+		resp.Diagnostics.AddError("Client Error", "Dnsmasq domain override data source requires a valid id.")
+		return
+	}
+
+	model, err := fetchDomainOverrideModel(ctx, d.client.Dnsmasq(), data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Dnsmasq domain override with ID %s not found.", data.Id.ValueString()))
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq domain override, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,43 @@
+package dnsmasq
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func domainOverrideResponseToModel(id string, resp *dnsmasq.DomainOverrideGetResponse) dnsmasqDomainOverrideResourceModel {
+	override := resp.DomainOverride
+
+	return dnsmasqDomainOverrideResourceModel{
+		Id:          types.StringValue(id),
+		Domain:      types.StringValue(override.Domain),
+		ServerIP:    types.StringValue(override.IP),
+		ServerPort:  types.Int64Value(tools.StringToInt64(override.Port)),
+		Description: types.StringValue(override.Description),
+	}
+}
+
+func (m *dnsmasqDomainOverrideResourceModel) toDomainOverride() dnsmasq.DomainOverride {
+	if m == nil {
+		return dnsmasq.DomainOverride{}
+	}
+
+	return dnsmasq.DomainOverride{
+		Domain:      stringToAPIValue(m.Domain),
+		IP:          stringToAPIValue(m.ServerIP),
+		Port:        int64ToAPIStringNegative(m.ServerPort),
+		Description: stringToAPIValue(m.Description),
+	}
+}
+
+func fetchDomainOverrideModel(ctx context.Context, controller *dnsmasq.Controller, id string) (dnsmasqDomainOverrideResourceModel, error) {
+	resp, err := controller.DnsmasqGetDomainOverride(ctx, id)
+	if err != nil {
+		return dnsmasqDomainOverrideResourceModel{}, err
+	}
+
+	return domainOverrideResponseToModel(id, resp), nil
+}
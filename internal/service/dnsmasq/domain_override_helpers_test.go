@@ -0,0 +1,60 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainOverrideToDomainOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    *dnsmasqDomainOverrideResourceModel
+		expected dnsmasq.DomainOverride
+	}{
+		{
+			name: "custom_port",
+			model: &dnsmasqDomainOverrideResourceModel{
+				Id:          types.StringValue("override-id"),
+				Domain:      types.StringValue("corp.example.com"),
+				ServerIP:    types.StringValue("10.0.0.53"),
+				ServerPort:  types.Int64Value(5353),
+				Description: types.StringValue("corporate DNS"),
+			},
+			expected: dnsmasq.DomainOverride{
+				Domain:      "corp.example.com",
+				IP:          "10.0.0.53",
+				Port:        "5353",
+				Description: "corporate DNS",
+			},
+		},
+		{
+			name: "default_port",
+			model: &dnsmasqDomainOverrideResourceModel{
+				Domain:      types.StringValue("lab.example.com"),
+				ServerIP:    types.StringValue("2001:db8::53"),
+				ServerPort:  types.Int64Value(-1),
+				Description: types.StringValue(""),
+			},
+			expected: dnsmasq.DomainOverride{
+				Domain:      "lab.example.com",
+				IP:          "2001:db8::53",
+				Port:        "",
+				Description: "",
+			},
+		},
+		{
+			name:     "nil",
+			model:    nil,
+			expected: dnsmasq.DomainOverride{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.model.toDomainOverride())
+		})
+	}
+}
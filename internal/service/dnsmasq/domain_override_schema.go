@@ -0,0 +1,102 @@
+package dnsmasq
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsmasqDomainOverrideResourceModel describes the Terraform model for a domain override.
+type dnsmasqDomainOverrideResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Domain      types.String `tfsdk:"domain"`
+	ServerIP    types.String `tfsdk:"server_ip"`
+	ServerPort  types.Int64  `tfsdk:"server_port"`
+	Description types.String `tfsdk:"description"`
+}
+
+func dnsmasqDomainOverrideResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage a Dnsmasq domain override, which sends all queries for a domain to a specific DNS server. Dnsmasq is reconfigured after every change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the domain override.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain whose queries are sent to the server, e.g. `corp.example.com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"server_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address of the DNS server that answers queries for the domain.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"server_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the DNS server. Set to `-1` to use the default port `53`. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func dnsmasqDomainOverrideDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read a Dnsmasq domain override.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the domain override.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain whose queries are sent to the server.",
+				Computed:            true,
+			},
+			"server_ip": dschema.StringAttribute{
+				MarkdownDescription: "IP address of the DNS server that answers queries for the domain.",
+				Computed:            true,
+			},
+			"server_port": dschema.Int64Attribute{
+				MarkdownDescription: "Port of the DNS server, or `-1` for the default port.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the domain override.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package dnsmasq
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newDnsmasqSettingsResource,
+		newDnsmasqHostOverrideResource,
+		newDnsmasqDomainOverrideResource,
+		newDnsmasqDHCPRangeResource,
+		newDnsmasqDHCPHostResource,
+		newDnsmasqDHCPTagResource,
+		newDnsmasqDHCPOptionResource,
+	}
+}
+
+func DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newDnsmasqSettingsDataSource,
+		newDnsmasqHostOverrideDataSource,
+		newDnsmasqDomainOverrideDataSource,
+		newDnsmasqDHCPRangeDataSource,
+		newDnsmasqDHCPHostDataSource,
+		newDnsmasqDHCPTagDataSource,
+		newDnsmasqDHCPOptionDataSource,
+	}
+}
@@ -0,0 +1,46 @@
+package dnsmasq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func hostOverrideResponseToModel(id string, resp *dnsmasq.HostGetResponse) dnsmasqHostOverrideResourceModel {
+	host := resp.Host
+
+	return dnsmasqHostOverrideResourceModel{
+		Id:          types.StringValue(id),
+		Hostname:    types.StringValue(host.Host),
+		Domain:      types.StringValue(host.Domain),
+		Addresses:   tools.StringSliceToSet(selectedOptionKeys(host.IP)),
+		Aliases:     tools.StringSliceToSet(selectedOptionKeys(host.Aliases)),
+		Description: types.StringValue(host.Description),
+	}
+}
+
+func (m *dnsmasqHostOverrideResourceModel) toHost() dnsmasq.Host {
+	if m == nil {
+		return dnsmasq.Host{}
+	}
+
+	return dnsmasq.Host{
+		Host:        stringToAPIValue(m.Hostname),
+		Domain:      stringToAPIValue(m.Domain),
+		IP:          strings.Join(tools.SetToStringSlice(m.Addresses), ","),
+		Aliases:     strings.Join(tools.SetToStringSlice(m.Aliases), ","),
+		Description: stringToAPIValue(m.Description),
+	}
+}
+
+func fetchHostOverrideModel(ctx context.Context, controller *dnsmasq.Controller, id string) (dnsmasqHostOverrideResourceModel, error) {
+	resp, err := controller.DnsmasqGetHost(ctx, id)
+	if err != nil {
+		return dnsmasqHostOverrideResourceModel{}, err
+	}
+
+	return hostOverrideResponseToModel(id, resp), nil
+}
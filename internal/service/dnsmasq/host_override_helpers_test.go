@@ -0,0 +1,65 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostOverrideToHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    *dnsmasqHostOverrideResourceModel
+		expected dnsmasq.Host
+	}{
+		{
+			name: "addresses_and_aliases",
+			model: &dnsmasqHostOverrideResourceModel{
+				Id:          types.StringValue("host-id"),
+				Hostname:    types.StringValue("nas"),
+				Domain:      types.StringValue("example.internal"),
+				Addresses:   tools.StringSliceToSet([]string{"192.168.1.20", "2001:db8::20"}),
+				Aliases:     tools.StringSliceToSet([]string{"files.example.internal"}),
+				Description: types.StringValue("storage"),
+			},
+			expected: dnsmasq.Host{
+				Host:        "nas",
+				Domain:      "example.internal",
+				IP:          "192.168.1.20,2001:db8::20",
+				Aliases:     "files.example.internal",
+				Description: "storage",
+			},
+		},
+		{
+			name: "wildcard_host",
+			model: &dnsmasqHostOverrideResourceModel{
+				Hostname:    types.StringValue("*"),
+				Domain:      types.StringValue("example.internal"),
+				Addresses:   tools.StringSliceToSet([]string{"192.168.1.30"}),
+				Aliases:     tools.StringSliceToSet([]string{}),
+				Description: types.StringNull(),
+			},
+			expected: dnsmasq.Host{
+				Host:        "*",
+				Domain:      "example.internal",
+				IP:          "192.168.1.30",
+				Aliases:     "",
+				Description: "",
+			},
+		},
+		{
+			name:     "nil",
+			model:    nil,
+			expected: dnsmasq.Host{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.model.toHost())
+		})
+	}
+}
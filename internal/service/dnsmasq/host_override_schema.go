@@ -0,0 +1,113 @@
+package dnsmasq
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsmasqHostOverrideResourceModel describes the Terraform model for a host override.
+type dnsmasqHostOverrideResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Domain      types.String `tfsdk:"domain"`
+	Addresses   types.Set    `tfsdk:"addresses"`
+	Aliases     types.Set    `tfsdk:"aliases"`
+	Description types.String `tfsdk:"description"`
+}
+
+func dnsmasqHostOverrideResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage a Dnsmasq host override, which answers queries for a host with fixed addresses. Dnsmasq is reconfigured after every change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the host override.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Name of the host, without the domain part. Use `*` to create a wildcard entry.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the host, e.g. `example.com`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"addresses": schema.SetAttribute{
+				MarkdownDescription: "IPv4 and IPv6 addresses returned for the host.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IP()),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				MarkdownDescription: "Additional fully qualified names that resolve to the same addresses, e.g. `www.example.com`. Defaults to `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func dnsmasqHostOverrideDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read a Dnsmasq host override.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the host override.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"hostname": dschema.StringAttribute{
+				MarkdownDescription: "Name of the host, without the domain part.",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain of the host.",
+				Computed:            true,
+			},
+			"addresses": dschema.SetAttribute{
+				MarkdownDescription: "IPv4 and IPv6 addresses returned for the host.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"aliases": dschema.SetAttribute{
+				MarkdownDescription: "Additional fully qualified names that resolve to the same addresses.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the host override.",
+				Computed:            true,
+			},
+		},
+	}
+}
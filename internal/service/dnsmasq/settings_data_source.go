@@ -0,0 +1,65 @@
+package dnsmasq
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var _ datasource.DataSource = &dnsmasqSettingsDataSource{}
+var _ datasource.DataSourceWithConfigure = &dnsmasqSettingsDataSource{}
+
+func newDnsmasqSettingsDataSource() datasource.DataSource {
+	return &dnsmasqSettingsDataSource{}
+}
+
+type dnsmasqSettingsDataSource struct {
+	client opnsense.Client
+}
+
+func (d *dnsmasqSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsmasq_settings"
+}
+
+func (d *dnsmasqSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dnsmasqSettingsDataSourceSchema()
+}
+
+func (d *dnsmasqSettingsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *dnsmasqSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *dnsmasqSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := d.client.Dnsmasq().DnsmasqGetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq settings, got error: %s", err))
+		return
+	}
+
+	resourceModel := settingsResponseToModel(settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
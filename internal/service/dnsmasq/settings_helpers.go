@@ -0,0 +1,104 @@
+package dnsmasq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func settingsResponseToModel(resp *dnsmasq.SettingsGetResponse) dnsmasqSettingsResourceModel {
+	general := resp.Dnsmasq
+
+	return dnsmasqSettingsResourceModel{
+		Enabled:          types.BoolValue(tools.StringToBool(general.Enabled)),
+		Interfaces:       tools.StringSliceToSet(selectedOptionKeys(general.Interface)),
+		Port:             types.Int64Value(tools.StringToInt64(general.Port)),
+		StrictOrder:      types.BoolValue(tools.StringToBool(general.StrictOrder)),
+		DomainNeeded:     types.BoolValue(tools.StringToBool(general.DomainNeeded)),
+		NoPrivateReverse: types.BoolValue(tools.StringToBool(general.NoPrivateReverse)),
+	}
+}
+
+func (m *dnsmasqSettingsResourceModel) toSettingsSetRequest() dnsmasq.SettingsSetRequest {
+	if m == nil {
+		return dnsmasq.SettingsSetRequest{}
+	}
+
+	return dnsmasq.SettingsSetRequest{
+		General: dnsmasq.General{
+			Enabled:          boolToAPIString(m.Enabled),
+			Interface:        strings.Join(tools.SetToStringSlice(m.Interfaces), ","),
+			Port:             int64ToAPIString(m.Port),
+			StrictOrder:      boolToAPIString(m.StrictOrder),
+			DomainNeeded:     boolToAPIString(m.DomainNeeded),
+			NoPrivateReverse: boolToAPIString(m.NoPrivateReverse),
+		},
+	}
+}
+
+// reconfigure applies the saved configuration to the running Dnsmasq service.
+func reconfigure(ctx context.Context, controller *dnsmasq.Controller) error {
+	res, err := controller.DnsmasqReconfigure(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to reconfigure dnsmasq: %w", err)
+	}
+	if res != nil && res.Result == "failed" {
+		return errors.New(formatActionResultFailure("reconfigure Dnsmasq", res))
+	}
+	return nil
+}
+
+func selectedOptionKeys(options api.FieldOptions) []string {
+	keys := make([]string, 0, len(options))
+	for key, option := range options {
+		if option.Selected == 1 && key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// selectedOptionKey returns the selected key of a single-select field, or an empty string.
+func selectedOptionKey(options api.FieldOptions) string {
+	if keys := selectedOptionKeys(options); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+func boolToAPIString(value types.Bool) string {
+	if value.IsNull() || value.IsUnknown() {
+		return tools.BoolToString(false)
+	}
+	return tools.BoolToString(value.ValueBool())
+}
+
+func int64ToAPIString(value types.Int64) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return tools.Int64ToString(value.ValueInt64())
+}
+
+// int64ToAPIStringNegative converts the value to a string, mapping `-1` to an empty value.
+func int64ToAPIStringNegative(value types.Int64) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return tools.Int64ToStringNegative(value.ValueInt64())
+}
+
+func stringToAPIValue(value types.String) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return value.ValueString()
+}
@@ -0,0 +1,91 @@
+package dnsmasq
+
+import (
+	"testing"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettingsToSetRequest(t *testing.T) {
+	model := &dnsmasqSettingsResourceModel{
+		Enabled:          types.BoolValue(true),
+		Interfaces:       tools.StringSliceToSet([]string{"lan", "opt1"}),
+		Port:             types.Int64Value(5353),
+		StrictOrder:      types.BoolValue(false),
+		DomainNeeded:     types.BoolValue(true),
+		NoPrivateReverse: types.BoolValue(true),
+	}
+
+	expected := dnsmasq.SettingsSetRequest{
+		General: dnsmasq.General{
+			Enabled:          "1",
+			Interface:        "lan,opt1",
+			Port:             "5353",
+			StrictOrder:      "0",
+			DomainNeeded:     "1",
+			NoPrivateReverse: "1",
+		},
+	}
+
+	assert.Equal(t, expected, model.toSettingsSetRequest())
+}
+
+func TestSettingsToSetRequestNil(t *testing.T) {
+	var model *dnsmasqSettingsResourceModel
+	assert.Equal(t, dnsmasq.SettingsSetRequest{}, model.toSettingsSetRequest())
+}
+
+func TestSelectedOptionKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		options api.FieldOptions
+		keys    []string
+		key     string
+	}{
+		{
+			name:    "none_selected",
+			options: api.FieldOptions{"lan": {Value: "LAN"}, "wan": {Value: "WAN"}},
+			keys:    []string{},
+			key:     "",
+		},
+		{
+			name:    "several_selected_are_sorted",
+			options: api.FieldOptions{"opt1": {Selected: 1}, "lan": {Selected: 1}, "wan": {}},
+			keys:    []string{"lan", "opt1"},
+			key:     "lan",
+		},
+		{
+			name:    "empty_key_is_ignored",
+			options: api.FieldOptions{"": {Value: "none", Selected: 1}},
+			keys:    []string{},
+			key:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.keys, selectedOptionKeys(tt.options))
+			assert.Equal(t, tt.key, selectedOptionKey(tt.options))
+		})
+	}
+}
+
+func TestSettingsAPIValues(t *testing.T) {
+	assert.Equal(t, "1", boolToAPIString(types.BoolValue(true)))
+	assert.Equal(t, "0", boolToAPIString(types.BoolNull()))
+
+	assert.Equal(t, "53", int64ToAPIString(types.Int64Value(53)))
+	assert.Equal(t, "-1", int64ToAPIString(types.Int64Value(-1)))
+	assert.Equal(t, "", int64ToAPIString(types.Int64Unknown()))
+
+	assert.Equal(t, "86400", int64ToAPIStringNegative(types.Int64Value(86400)))
+	assert.Equal(t, "", int64ToAPIStringNegative(types.Int64Value(-1)))
+	assert.Equal(t, "", int64ToAPIStringNegative(types.Int64Null()))
+
+	assert.Equal(t, "example.com", stringToAPIValue(types.StringValue("example.com")))
+	assert.Equal(t, "", stringToAPIValue(types.StringNull()))
+}
@@ -0,0 +1,144 @@
+package dnsmasq
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/dnsmasq"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &dnsmasqSettingsResource{}
+var _ resource.ResourceWithConfigure = &dnsmasqSettingsResource{}
+
+func newDnsmasqSettingsResource() resource.Resource {
+	return &dnsmasqSettingsResource{}
+}
+
+type dnsmasqSettingsResource struct {
+	client opnsense.Client
+}
+
+func (r *dnsmasqSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsmasq_settings"
+}
+
+func (r *dnsmasqSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dnsmasqSettingsResourceSchema()
+}
+
+func (r *dnsmasqSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+// applySettings saves the settings and reconfigures Dnsmasq so they take effect.
+func (r *dnsmasqSettingsResource) applySettings(ctx context.Context, operation string, settingsReq dnsmasq.SettingsSetRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := r.client.Dnsmasq().DnsmasqSetSettings(ctx, settingsReq)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", operation, err))
+		return diags
+	}
+	if res != nil && res.Result == "failed" {
+		diags.AddError("Client Error", formatActionResultFailure(operation, res))
+		return diags
+	}
+
+	if err := reconfigure(ctx, r.client.Dnsmasq()); err != nil {
+		diags.AddError("Client Error", err.Error())
+	}
+
+	return diags
+}
+
+func (r *dnsmasqSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *dnsmasqSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applySettings(ctx, "create Dnsmasq settings", data.toSettingsSetRequest())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created dnsmasq settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dnsmasqSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *dnsmasqSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.Dnsmasq().DnsmasqGetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dnsmasq settings, got error: %s", err))
+		return
+	}
+
+	resourceModel := settingsResponseToModel(settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
+
+func (r *dnsmasqSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *dnsmasqSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applySettings(ctx, "update Dnsmasq settings", data.toSettingsSetRequest())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dnsmasqSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *dnsmasqSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.Dnsmasq().DnsmasqGetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current dnsmasq settings during delete, got error: %s", err))
+		return
+	}
+
+	resourceModel := settingsResponseToModel(settings)
+	resourceModel.Enabled = types.BoolValue(false)
+
+	resp.Diagnostics.Append(r.applySettings(ctx, "disable Dnsmasq settings", resourceModel.toSettingsSetRequest())...)
+}
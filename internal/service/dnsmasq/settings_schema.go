@@ -0,0 +1,104 @@
+package dnsmasq
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsmasqSettingsResourceModel describes the Terraform model for the general settings.
+type dnsmasqSettingsResourceModel struct {
+	Enabled          types.Bool  `tfsdk:"enabled"`
+	Interfaces       types.Set   `tfsdk:"interfaces"`
+	Port             types.Int64 `tfsdk:"port"`
+	StrictOrder      types.Bool  `tfsdk:"strict_order"`
+	DomainNeeded     types.Bool  `tfsdk:"domain_needed"`
+	NoPrivateReverse types.Bool  `tfsdk:"no_private_reverse"`
+}
+
+func dnsmasqSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage the general settings of Dnsmasq. This is a singleton, only one instance of this resource should exist. Dnsmasq is reconfigured after every change. Destroying it disables Dnsmasq.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable Dnsmasq. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces Dnsmasq listens on, e.g. `lan`. Set to `[]` to listen on all interfaces. Defaults to `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port Dnsmasq answers DNS queries on. Set to `0` to disable DNS and only use DHCP. Defaults to `53`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(53),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"strict_order": schema.BoolAttribute{
+				MarkdownDescription: "Query the upstream servers in the order they are configured, instead of the fastest one first. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"domain_needed": schema.BoolAttribute{
+				MarkdownDescription: "Never forward queries for plain names without a domain part to the upstream servers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_private_reverse": schema.BoolAttribute{
+				MarkdownDescription: "Never forward reverse lookups for private address ranges to the upstream servers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func dnsmasqSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read the general settings of Dnsmasq.",
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Dnsmasq is enabled.",
+				Computed:            true,
+			},
+			"interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces Dnsmasq listens on. Empty when it listens on all interfaces.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "The port Dnsmasq answers DNS queries on, or `0` if DNS is disabled.",
+				Computed:            true,
+			},
+			"strict_order": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the upstream servers are queried in the order they are configured.",
+				Computed:            true,
+			},
+			"domain_needed": dschema.BoolAttribute{
+				MarkdownDescription: "Whether queries for plain names are kept from the upstream servers.",
+				Computed:            true,
+			},
+			"no_private_reverse": dschema.BoolAttribute{
+				MarkdownDescription: "Whether reverse lookups for private address ranges are kept from the upstream servers.",
+				Computed:            true,
+			},
+		},
+	}
+}
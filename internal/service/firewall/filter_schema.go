@@ -196,7 +196,7 @@ func filterResourceSchema() schema.Schema {
 				},
 			},
 			"gateway": schema.StringAttribute{
-				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway to utilize policy based routing. Defaults to `\"\"`.",
+				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway or gateway group (see `opnsense_gateway_group`) to utilize policy based routing. Defaults to `\"\"`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
@@ -299,7 +299,7 @@ func filterDataSourceSchema() dschema.Schema {
 				},
 			},
 			"gateway": dschema.StringAttribute{
-				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway to utilize policy based routing.",
+				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway or gateway group (see `opnsense_gateway_group`) to utilize policy based routing.",
 				Computed:            true,
 			},
 			"log": dschema.BoolAttribute{
@@ -10,11 +10,14 @@ import (
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newGatewayResource,
+		newGatewayGroupResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newGatewayDataSource,
+		newGatewayGroupDataSource,
+		newGatewayStatusDataSource,
 	}
 }
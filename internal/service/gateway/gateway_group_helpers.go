@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/gateway"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OPNsense stores gateway group members as `<gateway>|<tier>|<virtual ip>`.
+const gatewayGroupMemberSeparator = "|"
+
+func encodeGatewayGroupMember(m gatewayGroupMemberModel) string {
+	vip := stringValue(m.VirtualIP)
+	if vip == "" {
+		vip = "address"
+	}
+
+	tier := int64(1)
+	if !m.Tier.IsNull() && !m.Tier.IsUnknown() {
+		tier = m.Tier.ValueInt64()
+	}
+
+	return strings.Join([]string{
+		stringValue(m.Gateway),
+		tools.Int64ToString(tier),
+		vip,
+	}, gatewayGroupMemberSeparator)
+}
+
+func decodeGatewayGroupMember(s string) (gatewayGroupMemberModel, error) {
+	parts := strings.Split(s, gatewayGroupMemberSeparator)
+	if len(parts) < 2 || parts[0] == "" {
+		return gatewayGroupMemberModel{}, fmt.Errorf("malformed gateway group member %q", s)
+	}
+
+	tier, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return gatewayGroupMemberModel{}, fmt.Errorf("malformed tier in gateway group member %q: %w", s, err)
+	}
+
+	vip := "address"
+	if len(parts) > 2 && parts[2] != "" {
+		vip = parts[2]
+	}
+
+	return gatewayGroupMemberModel{
+		Gateway:   types.StringValue(parts[0]),
+		Tier:      types.Int64Value(tier),
+		VirtualIP: types.StringValue(vip),
+	}, nil
+}
+
+func convertGatewayGroupSchemaToRequest(ctx context.Context, d *gatewayGroupResourceModel) (gateway.GatewayGroupRequest, error) {
+	if d == nil {
+		return gateway.GatewayGroupRequest{}, nil
+	}
+
+	var members []gatewayGroupMemberModel
+	if diags := d.Members.ElementsAs(ctx, &members, false); diags.HasError() {
+		return gateway.GatewayGroupRequest{}, fmt.Errorf("unable to read gateway group members: %v", diags)
+	}
+
+	encoded := make([]string, 0, len(members))
+	for _, m := range members {
+		encoded = append(encoded, encodeGatewayGroupMember(m))
+	}
+	// Keep the payload stable regardless of set ordering.
+	sort.Strings(encoded)
+
+	return gateway.GatewayGroupRequest{
+		Name:        stringValue(d.Name),
+		Description: stringValue(d.Description),
+		Members:     encoded,
+		Trigger:     stringValue(d.Trigger),
+		PoolOptions: stringValue(d.PoolOptions),
+	}, nil
+}
+
+func gatewayGroupResponseToModel(ctx context.Context, id string, resp *gateway.GetGatewayGroupResponse) (*gatewayGroupResourceModel, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("empty response for gateway group %s", id)
+	}
+
+	data := resp.GatewayGroup
+
+	members := []gatewayGroupMemberModel{}
+	for _, s := range data.Members {
+		if s == "" {
+			continue
+		}
+		m, err := decodeGatewayGroupMember(s)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	membersSet, diags := types.SetValueFrom(ctx, types.ObjectType{}.WithAttributeTypes(gatewayGroupMemberAttrTypes), members)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert gateway group members: %v", diags)
+	}
+
+	trigger := selectedOptionKey(data.Trigger)
+	if trigger == "" {
+		trigger = "down"
+	}
+
+	return &gatewayGroupResourceModel{
+		Id:          types.StringValue(id),
+		Name:        types.StringValue(data.Name),
+		Description: tools.StringOrNull(data.Description),
+		Members:     membersSet,
+		Trigger:     types.StringValue(trigger),
+		PoolOptions: types.StringValue(selectedOptionKey(data.PoolOptions)),
+	}, nil
+}
+
+func fetchGatewayGroupModel(ctx context.Context, ctrl *gateway.Controller, id string) (*gatewayGroupResourceModel, error) {
+	resp, err := ctrl.SettingsGetGatewayGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return gatewayGroupResponseToModel(ctx, id, resp)
+}
@@ -0,0 +1,60 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeGatewayGroupMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    gatewayGroupMemberModel
+		expected string
+	}{
+		{
+			name: "interface_address",
+			input: gatewayGroupMemberModel{
+				Gateway:   types.StringValue("WAN_GW"),
+				Tier:      types.Int64Value(2),
+				VirtualIP: types.StringValue("address"),
+			},
+			expected: "WAN_GW|2|address",
+		},
+		{
+			name: "defaults",
+			input: gatewayGroupMemberModel{
+				Gateway:   types.StringValue("WAN_GW"),
+				Tier:      types.Int64Null(),
+				VirtualIP: types.StringNull(),
+			},
+			expected: "WAN_GW|1|address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, encodeGatewayGroupMember(tt.input))
+		})
+	}
+}
+
+func TestDecodeGatewayGroupMember(t *testing.T) {
+	m, err := decodeGatewayGroupMember("WAN2_GW|3|203.0.113.10")
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue("WAN2_GW"), m.Gateway)
+	assert.Equal(t, types.Int64Value(3), m.Tier)
+	assert.Equal(t, types.StringValue("203.0.113.10"), m.VirtualIP)
+
+	m, err = decodeGatewayGroupMember("WAN_GW|1")
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue("address"), m.VirtualIP)
+
+	_, err = decodeGatewayGroupMember("WAN_GW|high")
+	assert.Error(t, err)
+
+	_, err = decodeGatewayGroupMember("WAN_GW")
+	assert.Error(t, err)
+}
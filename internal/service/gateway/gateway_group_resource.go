@@ -0,0 +1,226 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &gatewayGroupResource{}
+var _ resource.ResourceWithConfigure = &gatewayGroupResource{}
+var _ resource.ResourceWithImportState = &gatewayGroupResource{}
+
+func newGatewayGroupResource() resource.Resource {
+	return &gatewayGroupResource{}
+}
+
+type gatewayGroupResource struct {
+	client opnsense.Client
+}
+
+func (r *gatewayGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_group"
+}
+
+func (r *gatewayGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = gatewayGroupResourceSchema()
+}
+
+func (r *gatewayGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *gatewayGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gatewayGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := convertGatewayGroupSchemaToRequest(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	result, err := r.client.Gateway().SettingsAddGatewayGroup(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create gateway group, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error",
+			formatActionResultFailure("create gateway group", result))
+		return
+	}
+
+	if result == nil || result.UUID == "" {
+		resp.Diagnostics.AddError("Client Error", "API did not return an identifier for the new gateway group.")
+		return
+	}
+
+	data.Id = types.StringValue(result.UUID)
+
+	applyResult, err := r.client.Gateway().SettingsApplyGateways(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to apply gateway changes after create, got error: %s", err))
+		return
+	}
+	if applyResult != nil && applyResult.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error",
+			formatActionResultFailure("apply gateway changes", applyResult))
+		return
+	}
+
+	model, err := fetchGatewayGroupModel(ctx, r.client.Gateway(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read gateway group after create, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created gateway group", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *gatewayGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gatewayGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := fetchGatewayGroupModel(ctx, r.client.Gateway(), data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, "gateway group not present in remote, removing from state", map[string]any{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read gateway group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *gatewayGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gatewayGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := convertGatewayGroupSchemaToRequest(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	result, err := r.client.Gateway().SettingsSetGatewayGroup(ctx, request, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update gateway group, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error",
+			formatActionResultFailure("update gateway group", result))
+		return
+	}
+
+	applyResult, err := r.client.Gateway().SettingsApplyGateways(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to apply gateway changes after update, got error: %s", err))
+		return
+	}
+	if applyResult != nil && applyResult.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error",
+			formatActionResultFailure("apply gateway changes", applyResult))
+		return
+	}
+
+	model, err := fetchGatewayGroupModel(ctx, r.client.Gateway(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read gateway group after update, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *gatewayGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gatewayGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Gateway().SettingsDeleteGatewayGroup(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete gateway group, got error: %s", err))
+		return
+	}
+
+	if result != nil && result.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error",
+			formatActionResultFailure("delete gateway group", result))
+		return
+	}
+
+	applyResult, err := r.client.Gateway().SettingsApplyGateways(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to apply gateway changes after delete, got error: %s", err))
+		return
+	}
+	if applyResult != nil && applyResult.Result == "failed" {
+		resp.Diagnostics.AddError("Client Error",
+			formatActionResultFailure("apply gateway changes", applyResult))
+		return
+	}
+}
+
+func (r *gatewayGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,156 @@
+package gateway
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type gatewayGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+	Trigger     types.String `tfsdk:"trigger"`
+	PoolOptions types.String `tfsdk:"pool_options"`
+}
+
+type gatewayGroupMemberModel struct {
+	Gateway   types.String `tfsdk:"gateway"`
+	Tier      types.Int64  `tfsdk:"tier"`
+	VirtualIP types.String `tfsdk:"virtual_ip"`
+}
+
+var gatewayGroupMemberAttrTypes = map[string]attr.Type{
+	"gateway":    types.StringType,
+	"tier":       types.Int64Type,
+	"virtual_ip": types.StringType,
+}
+
+func gatewayGroupResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage gateway groups under **System → Gateways → Group** for multi-WAN failover and load balancing. Reference the group `name` in `opnsense_firewall_filter.gateway` to policy route traffic through it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the gateway group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Unique name for the gateway group, e.g. `WAN_FAILOVER`.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional free-form description for your reference.",
+				Optional:            true,
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "Gateways in this group. Members on the same tier are load balanced, lower tiers are preferred and higher tiers are used for failover.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Name of the member gateway, e.g. `opnsense_settings_gateway.wan.name`.",
+							Required:            true,
+						},
+						"tier": schema.Int64Attribute{
+							MarkdownDescription: "Tier of the member, from `1` (highest priority) to `5`. Defaults to `1`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(1),
+							Validators: []validator.Int64{
+								int64validator.Between(1, 5),
+							},
+						},
+						"virtual_ip": schema.StringAttribute{
+							MarkdownDescription: "Virtual IP to use as the source address on this member, or `address` to use the interface address. Defaults to `address`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("address"),
+						},
+					},
+				},
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "When to exclude a member from the group. Available values: `down` (member down), `downloss` (packet loss), `downlatency` (high latency), `downlosslatency` (packet loss or high latency). Defaults to `down`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("down"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("down", "downloss", "downlatency", "downlosslatency"),
+				},
+			},
+			"pool_options": schema.StringAttribute{
+				MarkdownDescription: "Pool options used to balance connections over members on the same tier. Available values: `\"\"` (system default), `round-robin`, `round-robin sticky-address`, `random`, `random sticky-address`, `source-hash`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "round-robin", "round-robin sticky-address", "random", "random sticky-address", "source-hash"),
+				},
+			},
+		},
+	}
+}
+
+func gatewayGroupDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Look up an existing gateway group under **System → Gateways → Group** by UUID.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the gateway group.",
+				Required:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Gateway group name.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Gateway group description.",
+				Computed:            true,
+			},
+			"members": dschema.SetNestedAttribute{
+				MarkdownDescription: "Gateways in this group.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"gateway": dschema.StringAttribute{
+							MarkdownDescription: "Name of the member gateway.",
+							Computed:            true,
+						},
+						"tier": dschema.Int64Attribute{
+							MarkdownDescription: "Tier of the member.",
+							Computed:            true,
+						},
+						"virtual_ip": dschema.StringAttribute{
+							MarkdownDescription: "Virtual IP used on this member.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"trigger": dschema.StringAttribute{
+				MarkdownDescription: "When a member is excluded from the group.",
+				Computed:            true,
+			},
+			"pool_options": dschema.StringAttribute{
+				MarkdownDescription: "Pool options used to balance connections.",
+				Computed:            true,
+			},
+		},
+	}
+}
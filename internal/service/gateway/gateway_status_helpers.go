@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/gateway"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func gatewayStatusResponseToModel(ctx context.Context, resp *gateway.GatewayStatusResponse) (*gatewayStatusDataSourceModel, error) {
+	items := []gatewayStatusModel{}
+	if resp != nil {
+		for _, item := range resp.Items {
+			items = append(items, gatewayStatusModel{
+				Name:              types.StringValue(item.Name),
+				Address:           types.StringValue(item.Address),
+				Monitor:           types.StringValue(item.Monitor),
+				Status:            types.StringValue(item.Status),
+				StatusDescription: types.StringValue(item.StatusTranslated),
+				RTT:               parseGatewayMetric(item.Delay),
+				RTTStdDev:         parseGatewayMetric(item.StdDev),
+				Loss:              parseGatewayMetric(item.Loss),
+			})
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: gatewayStatusAttrTypes}, items)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error converting gateway status: %v", diags)
+	}
+
+	return &gatewayStatusDataSourceModel{Gateways: list}, nil
+}
+
+// parseGatewayMetric converts dpinger values such as `12.3 ms` or `0.0 %` to a number.
+// Gateways without monitoring report `~`, which is returned as null.
+func parseGatewayMetric(value string) types.Float64 {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return types.Float64Null()
+	}
+
+	f, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "%"), 64)
+	if err != nil {
+		return types.Float64Null()
+	}
+	return types.Float64Value(f)
+}
@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGatewayMetric(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected types.Float64
+	}{
+		{name: "milliseconds", input: "12.3 ms", expected: types.Float64Value(12.3)},
+		{name: "percent", input: "0.0 %", expected: types.Float64Value(0)},
+		{name: "percent_without_space", input: "5%", expected: types.Float64Value(5)},
+		{name: "not_monitored", input: "~", expected: types.Float64Null()},
+		{name: "empty", input: "", expected: types.Float64Null()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseGatewayMetric(tt.input))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package gateway
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type gatewayStatusDataSourceModel struct {
+	Gateways types.List `tfsdk:"gateways"`
+}
+
+type gatewayStatusModel struct {
+	Name              types.String  `tfsdk:"name"`
+	Address           types.String  `tfsdk:"address"`
+	Monitor           types.String  `tfsdk:"monitor"`
+	Status            types.String  `tfsdk:"status"`
+	StatusDescription types.String  `tfsdk:"status_description"`
+	RTT               types.Float64 `tfsdk:"rtt"`
+	RTTStdDev         types.Float64 `tfsdk:"rtt_stddev"`
+	Loss              types.Float64 `tfsdk:"loss"`
+}
+
+var gatewayStatusAttrTypes = map[string]attr.Type{
+	"name":               types.StringType,
+	"address":            types.StringType,
+	"monitor":            types.StringType,
+	"status":             types.StringType,
+	"status_description": types.StringType,
+	"rtt":                types.Float64Type,
+	"rtt_stddev":         types.Float64Type,
+	"loss":               types.Float64Type,
+}
+
+func gatewayStatusDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read the current dpinger monitoring results for all gateways under **System → Gateways → Configuration**.",
+		Attributes: map[string]dschema.Attribute{
+			"gateways": dschema.ListNestedAttribute{
+				MarkdownDescription: "Status of every configured gateway.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"name": dschema.StringAttribute{
+							MarkdownDescription: "Name of the gateway, matching `opnsense_settings_gateway.name`.",
+							Computed:            true,
+						},
+						"address": dschema.StringAttribute{
+							MarkdownDescription: "Gateway IP address.",
+							Computed:            true,
+						},
+						"monitor": dschema.StringAttribute{
+							MarkdownDescription: "Address monitored by dpinger.",
+							Computed:            true,
+						},
+						"status": dschema.StringAttribute{
+							MarkdownDescription: "Gateway status as reported by dpinger: `none` (online), `down`, `loss`, `delay`, `delay+loss` or `force_down`.",
+							Computed:            true,
+						},
+						"status_description": dschema.StringAttribute{
+							MarkdownDescription: "Human readable gateway status, e.g. `Online`.",
+							Computed:            true,
+						},
+						"rtt": dschema.Float64Attribute{
+							MarkdownDescription: "Average round trip time in milliseconds. Null when monitoring is disabled.",
+							Computed:            true,
+						},
+						"rtt_stddev": dschema.Float64Attribute{
+							MarkdownDescription: "Standard deviation of the round trip time in milliseconds. Null when monitoring is disabled.",
+							Computed:            true,
+						},
+						"loss": dschema.Float64Attribute{
+							MarkdownDescription: "Packet loss in percent. Null when monitoring is disabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Address family validation
+			{
+				Config:      testAccAssignmentResourceConfig("Assignment test", "2001:db8::1/64"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be an IPv4 address"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccAssignmentResourceConfig("Assignment test", "192.168.210.1/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "description", "Assignment test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv4_type", "static"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv4_address", "192.168.210.1/24"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv6_type", "none"),
+					resource.TestCheckResourceAttrPair("opnsense_interfaces_assignment.test", "device", "opnsense_interfaces_vlan.test", "device"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_assignment.test", "id", regexp.MustCompile(`^opt\d+$`)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAssignmentResourceConfig("Updated assignment", "192.168.211.1/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "description", "Updated assignment"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv4_address", "192.168.211.1/24"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAssignmentResourceConfig(description string, address string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_vlan" "test" {
+  tag         = 210
+  description = "Assignment test vlan"
+  priority    = 0
+  parent      = "vtnet0"
+}
+
+resource "opnsense_interfaces_assignment" "test" {
+  device       = opnsense_interfaces_vlan.test.device
+  description  = %[1]q
+  ipv4_type    = "static"
+  ipv4_address = %[2]q
+}
+`, description, address)
+}
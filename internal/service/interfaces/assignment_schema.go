@@ -0,0 +1,372 @@
+package interfaces
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// assignmentResourceModel describes the resource data model.
+type assignmentResourceModel struct {
+	Device       types.String `tfsdk:"device"`
+	Description  types.String `tfsdk:"description"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	BlockPrivate types.Bool   `tfsdk:"block_private"`
+	BlockBogons  types.Bool   `tfsdk:"block_bogons"`
+	MTU          types.Int64  `tfsdk:"mtu"`
+	MSS          types.Int64  `tfsdk:"mss"`
+
+	IPv4Type    types.String `tfsdk:"ipv4_type"`
+	IPv4Address types.String `tfsdk:"ipv4_address"`
+	IPv4Gateway types.String `tfsdk:"ipv4_gateway"`
+
+	IPv6Type        types.String `tfsdk:"ipv6_type"`
+	IPv6Address     types.String `tfsdk:"ipv6_address"`
+	IPv6Gateway     types.String `tfsdk:"ipv6_gateway"`
+	Track6Interface types.String `tfsdk:"track6_interface"`
+	Track6PrefixID  types.Int64  `tfsdk:"track6_prefix_id"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func assignmentResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Interface assignments attach a device (e.g. a VLAN) to a logical interface and configure its addressing.",
+
+		Attributes: map[string]schema.Attribute{
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Device to assign to the logical interface, e.g. `vtnet1` or `vlan0.100`.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the interface, shown throughout the GUI (e.g. `DMZ`).",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this interface. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"block_private": schema.BoolAttribute{
+				MarkdownDescription: "Block traffic from private networks (RFC 1918) and loopback addresses. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"block_bogons": schema.BoolAttribute{
+				MarkdownDescription: "Block traffic from reserved IP addresses not assigned by IANA. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of the interface. Set to `-1` to use the device default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(576, 65535),
+					),
+				},
+			},
+			"mss": schema.Int64Attribute{
+				MarkdownDescription: "TCP MSS clamping value for the interface. Set to `-1` to disable clamping. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(576, 65535),
+					),
+				},
+			},
+			"ipv4_type": schema.StringAttribute{
+				MarkdownDescription: "IPv4 configuration type. Available values: `none`, `static`, `dhcp`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "static", "dhcp"),
+				},
+			},
+			"ipv4_address": schema.StringAttribute{
+				MarkdownDescription: "Static IPv4 address with prefix length, e.g. `192.168.10.1/24`. Must be set when `ipv4_type` is `static`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						validators.CIDR(),
+					),
+				},
+			},
+			"ipv4_gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the upstream IPv4 gateway for a static configuration (see `opnsense_settings_gateway`). Set to `\"\"` for a local (LAN type) interface. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"ipv6_type": schema.StringAttribute{
+				MarkdownDescription: "IPv6 configuration type. Available values: `none`, `static`, `dhcp6`, `slaac`, `track6`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "static", "dhcp6", "slaac", "track6"),
+				},
+			},
+			"ipv6_address": schema.StringAttribute{
+				MarkdownDescription: "Static IPv6 address with prefix length, e.g. `2001:db8::1/64`. Must be set when `ipv6_type` is `static`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						validators.CIDR(),
+					),
+				},
+			},
+			"ipv6_gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the upstream IPv6 gateway for a static configuration (see `opnsense_settings_gateway`). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"track6_interface": schema.StringAttribute{
+				MarkdownDescription: "Logical identifier of the interface to track for a delegated prefix, e.g. `wan`. Must be set when `ipv6_type` is `track6`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"track6_prefix_id": schema.Int64Attribute{
+				MarkdownDescription: "Prefix ID to use from the delegated prefix when `ipv6_type` is `track6`. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Logical identifier of the interface, e.g. `opt3`. Use this value in the `interface` attributes of other resources.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func assignmentDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Interface assignments attach a device (e.g. a VLAN) to a logical interface and configure its addressing.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "Logical identifier of the interface, e.g. `opt3`.",
+				Required:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "Device assigned to the logical interface.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the interface.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this interface is enabled.",
+				Computed:            true,
+			},
+			"block_private": dschema.BoolAttribute{
+				MarkdownDescription: "Whether traffic from private networks is blocked.",
+				Computed:            true,
+			},
+			"block_bogons": dschema.BoolAttribute{
+				MarkdownDescription: "Whether traffic from bogon networks is blocked.",
+				Computed:            true,
+			},
+			"mtu": dschema.Int64Attribute{
+				MarkdownDescription: "MTU of the interface, `-1` when the device default is used.",
+				Computed:            true,
+			},
+			"mss": dschema.Int64Attribute{
+				MarkdownDescription: "TCP MSS clamping value, `-1` when disabled.",
+				Computed:            true,
+			},
+			"ipv4_type": dschema.StringAttribute{
+				MarkdownDescription: "IPv4 configuration type.",
+				Computed:            true,
+			},
+			"ipv4_address": dschema.StringAttribute{
+				MarkdownDescription: "Static IPv4 address with prefix length.",
+				Computed:            true,
+			},
+			"ipv4_gateway": dschema.StringAttribute{
+				MarkdownDescription: "Name of the upstream IPv4 gateway.",
+				Computed:            true,
+			},
+			"ipv6_type": dschema.StringAttribute{
+				MarkdownDescription: "IPv6 configuration type.",
+				Computed:            true,
+			},
+			"ipv6_address": dschema.StringAttribute{
+				MarkdownDescription: "Static IPv6 address with prefix length.",
+				Computed:            true,
+			},
+			"ipv6_gateway": dschema.StringAttribute{
+				MarkdownDescription: "Name of the upstream IPv6 gateway.",
+				Computed:            true,
+			},
+			"track6_interface": dschema.StringAttribute{
+				MarkdownDescription: "Logical identifier of the tracked interface.",
+				Computed:            true,
+			},
+			"track6_prefix_id": dschema.Int64Attribute{
+				MarkdownDescription: "Prefix ID used from the delegated prefix.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// validateAssignmentModel checks the combinations of addressing attributes
+// that cannot be expressed with per-attribute validators.
+func validateAssignmentModel(d *assignmentResourceModel) []string {
+	var problems []string
+
+	if !d.IPv4Type.IsUnknown() && !d.IPv4Address.IsUnknown() {
+		if d.IPv4Type.ValueString() == "static" && d.IPv4Address.ValueString() == "" {
+			problems = append(problems, "`ipv4_address` must be set when `ipv4_type` is `static`.")
+		}
+		if d.IPv4Type.ValueString() != "static" && d.IPv4Address.ValueString() != "" {
+			problems = append(problems, "`ipv4_address` can only be set when `ipv4_type` is `static`.")
+		}
+	}
+
+	if !d.IPv4Address.IsUnknown() {
+		if ip, _, err := net.ParseCIDR(d.IPv4Address.ValueString()); err == nil && ip.To4() == nil {
+			problems = append(problems, "`ipv4_address` must be an IPv4 address.")
+		}
+	}
+
+	if !d.IPv6Type.IsUnknown() && !d.IPv6Address.IsUnknown() {
+		if d.IPv6Type.ValueString() == "static" && d.IPv6Address.ValueString() == "" {
+			problems = append(problems, "`ipv6_address` must be set when `ipv6_type` is `static`.")
+		}
+		if d.IPv6Type.ValueString() != "static" && d.IPv6Address.ValueString() != "" {
+			problems = append(problems, "`ipv6_address` can only be set when `ipv6_type` is `static`.")
+		}
+	}
+
+	if !d.IPv6Address.IsUnknown() {
+		if ip, _, err := net.ParseCIDR(d.IPv6Address.ValueString()); err == nil && ip.To4() != nil {
+			problems = append(problems, "`ipv6_address` must be an IPv6 address.")
+		}
+	}
+
+	if !d.IPv6Type.IsUnknown() && !d.Track6Interface.IsUnknown() {
+		if d.IPv6Type.ValueString() == "track6" && d.Track6Interface.ValueString() == "" {
+			problems = append(problems, "`track6_interface` must be set when `ipv6_type` is `track6`.")
+		}
+	}
+
+	return problems
+}
+
+func convertAssignmentSchemaToStruct(d *assignmentResourceModel) (*interfaces.Assignment, error) {
+	ipv4Address, ipv4Subnet, err := splitAddressPrefix(d.IPv4Address.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	ipv6Address, ipv6Subnet, err := splitAddressPrefix(d.IPv6Address.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &interfaces.Assignment{
+		Device:          api.SelectedMap(d.Device.ValueString()),
+		Description:     d.Description.ValueString(),
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		BlockPrivate:    tools.BoolToString(d.BlockPrivate.ValueBool()),
+		BlockBogons:     tools.BoolToString(d.BlockBogons.ValueBool()),
+		MTU:             tools.Int64ToStringNegative(d.MTU.ValueInt64()),
+		MSS:             tools.Int64ToStringNegative(d.MSS.ValueInt64()),
+		IPv4Type:        api.SelectedMap(d.IPv4Type.ValueString()),
+		IPv4Address:     ipv4Address,
+		IPv4Subnet:      ipv4Subnet,
+		IPv4Gateway:     api.SelectedMap(d.IPv4Gateway.ValueString()),
+		IPv6Type:        api.SelectedMap(d.IPv6Type.ValueString()),
+		IPv6Address:     ipv6Address,
+		IPv6Subnet:      ipv6Subnet,
+		IPv6Gateway:     api.SelectedMap(d.IPv6Gateway.ValueString()),
+		Track6Interface: api.SelectedMap(d.Track6Interface.ValueString()),
+		Track6PrefixID:  tools.Int64ToString(d.Track6PrefixID.ValueInt64()),
+	}, nil
+}
+
+func convertAssignmentStructToSchema(d *interfaces.Assignment) (*assignmentResourceModel, error) {
+	return &assignmentResourceModel{
+		Device:          types.StringValue(d.Device.String()),
+		Description:     tools.StringOrNull(d.Description),
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		BlockPrivate:    types.BoolValue(tools.StringToBool(d.BlockPrivate)),
+		BlockBogons:     types.BoolValue(tools.StringToBool(d.BlockBogons)),
+		MTU:             types.Int64Value(tools.StringToInt64(d.MTU)),
+		MSS:             types.Int64Value(tools.StringToInt64(d.MSS)),
+		IPv4Type:        types.StringValue(d.IPv4Type.String()),
+		IPv4Address:     types.StringValue(joinAddressPrefix(d.IPv4Address, d.IPv4Subnet)),
+		IPv4Gateway:     types.StringValue(d.IPv4Gateway.String()),
+		IPv6Type:        types.StringValue(d.IPv6Type.String()),
+		IPv6Address:     types.StringValue(joinAddressPrefix(d.IPv6Address, d.IPv6Subnet)),
+		IPv6Gateway:     types.StringValue(d.IPv6Gateway.String()),
+		Track6Interface: types.StringValue(d.Track6Interface.String()),
+		Track6PrefixID:  types.Int64Value(max(tools.StringToInt64(d.Track6PrefixID), 0)),
+	}, nil
+}
+
+// splitAddressPrefix splits `192.168.1.1/24` into the address and prefix
+// length fields OPNsense stores separately.
+func splitAddressPrefix(s string) (string, string, error) {
+	if s == "" {
+		return "", "", nil
+	}
+
+	address, prefix, found := strings.Cut(s, "/")
+	if !found {
+		return "", "", fmt.Errorf("address %q is missing a prefix length", s)
+	}
+
+	return address, prefix, nil
+}
+
+func joinAddressPrefix(address, prefix string) string {
+	if address == "" {
+		return ""
+	}
+	return address + "/" + prefix
+}
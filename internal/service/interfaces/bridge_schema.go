@@ -0,0 +1,267 @@
+package interfaces
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bridgeResourceModel describes the resource data model.
+type bridgeResourceModel struct {
+	Members         types.Set    `tfsdk:"members"`
+	STPEnabled      types.Bool   `tfsdk:"stp_enabled"`
+	STPProtocol     types.String `tfsdk:"stp_protocol"`
+	STPInterfaces   types.Set    `tfsdk:"stp_interfaces"`
+	STPMaxAge       types.Int64  `tfsdk:"stp_max_age"`
+	STPForwardDelay types.Int64  `tfsdk:"stp_forward_delay"`
+	STPHoldCount    types.Int64  `tfsdk:"stp_hold_count"`
+	STPPriority     types.Int64  `tfsdk:"stp_priority"`
+	SpanPorts       types.Set    `tfsdk:"span_ports"`
+	LinkLocal       types.Bool   `tfsdk:"link_local"`
+	Learning        types.Bool   `tfsdk:"learning"`
+	Description     types.String `tfsdk:"description"`
+	Device          types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func bridgeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Bridges connect multiple interfaces into a single layer 2 broadcast domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"members": schema.SetAttribute{
+				MarkdownDescription: "Set of member interfaces to bridge, e.g. `[\"vtnet1\", \"vxlan0\"]`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"stp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the spanning tree protocol on this bridge. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"stp_protocol": schema.StringAttribute{
+				MarkdownDescription: "Spanning tree protocol to use. Available values: `stp`, `rstp`. Only applies when `stp_enabled = true`. Defaults to `rstp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("rstp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("stp", "rstp"),
+				},
+			},
+			"stp_interfaces": schema.SetAttribute{
+				MarkdownDescription: "Set of member interfaces on which spanning tree is enabled. Only applies when `stp_enabled = true`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"stp_max_age": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds that a spanning tree protocol configuration is valid. Set to `-1` to use the system default (20 seconds). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(6, 40),
+					),
+				},
+			},
+			"stp_forward_delay": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds that must pass before an interface begins forwarding packets when spanning tree is enabled. Set to `-1` to use the system default (15 seconds). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(4, 30),
+					),
+				},
+			},
+			"stp_hold_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of packets transmitted per second before rate limiting. Set to `-1` to use the system default (6 packets). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 10),
+					),
+				},
+			},
+			"stp_priority": schema.Int64Attribute{
+				MarkdownDescription: "Spanning tree bridge priority, the lowest priority becomes the root bridge. Set to `-1` to use the system default (32768). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(0, 61440),
+					),
+				},
+			},
+			"span_ports": schema.SetAttribute{
+				MarkdownDescription: "Set of interfaces that receive a copy of every frame sent through the bridge. Span ports must not be bridge members. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"link_local": schema.BoolAttribute{
+				MarkdownDescription: "Enable an IPv6 link-local address on the bridge device. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"learning": schema.BoolAttribute{
+				MarkdownDescription: "Learn the source MAC addresses of frames received on member interfaces. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Name of the bridge device generated by OPNsense, e.g. `bridge0`. Use this as the `device` of an interface assignment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the bridge.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func bridgeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Bridges connect multiple interfaces into a single layer 2 broadcast domain.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"members": dschema.SetAttribute{
+				MarkdownDescription: "Set of member interfaces.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"stp_enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the spanning tree protocol is enabled.",
+				Computed:            true,
+			},
+			"stp_protocol": dschema.StringAttribute{
+				MarkdownDescription: "Spanning tree protocol in use.",
+				Computed:            true,
+			},
+			"stp_interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Set of member interfaces on which spanning tree is enabled.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"stp_max_age": dschema.Int64Attribute{
+				MarkdownDescription: "Time in seconds that a spanning tree protocol configuration is valid. `-1` means the system default is used.",
+				Computed:            true,
+			},
+			"stp_forward_delay": dschema.Int64Attribute{
+				MarkdownDescription: "Time in seconds before an interface begins forwarding packets. `-1` means the system default is used.",
+				Computed:            true,
+			},
+			"stp_hold_count": dschema.Int64Attribute{
+				MarkdownDescription: "Number of packets transmitted per second before rate limiting. `-1` means the system default is used.",
+				Computed:            true,
+			},
+			"stp_priority": dschema.Int64Attribute{
+				MarkdownDescription: "Spanning tree bridge priority. `-1` means the system default is used.",
+				Computed:            true,
+			},
+			"span_ports": dschema.SetAttribute{
+				MarkdownDescription: "Set of interfaces that receive a copy of every frame sent through the bridge.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"link_local": dschema.BoolAttribute{
+				MarkdownDescription: "Whether an IPv6 link-local address is enabled on the bridge device.",
+				Computed:            true,
+			},
+			"learning": dschema.BoolAttribute{
+				MarkdownDescription: "Whether source MAC addresses are learned on member interfaces.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "Name of the bridge device, e.g. `bridge0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertBridgeSchemaToStruct(d *bridgeResourceModel) (*interfaces.Bridge, error) {
+	return &interfaces.Bridge{
+		Members:         api.SelectedMapList(tools.SetToStringSlice(d.Members)),
+		STPEnabled:      tools.BoolToString(d.STPEnabled.ValueBool()),
+		STPProtocol:     api.SelectedMap(d.STPProtocol.ValueString()),
+		STPInterfaces:   api.SelectedMapList(tools.SetToStringSlice(d.STPInterfaces)),
+		STPMaxAge:       tools.Int64ToStringNegative(d.STPMaxAge.ValueInt64()),
+		STPForwardDelay: tools.Int64ToStringNegative(d.STPForwardDelay.ValueInt64()),
+		STPHoldCount:    tools.Int64ToStringNegative(d.STPHoldCount.ValueInt64()),
+		STPPriority:     tools.Int64ToStringNegative(d.STPPriority.ValueInt64()),
+		SpanPorts:       api.SelectedMapList(tools.SetToStringSlice(d.SpanPorts)),
+		LinkLocal:       tools.BoolToString(d.LinkLocal.ValueBool()),
+		Learning:        tools.BoolToString(d.Learning.ValueBool()),
+		Description:     d.Description.ValueString(),
+		Device:          d.Device.ValueString(),
+	}, nil
+}
+
+func convertBridgeStructToSchema(d *interfaces.Bridge) (*bridgeResourceModel, error) {
+	return &bridgeResourceModel{
+		Members:         tools.StringSliceToSet(d.Members),
+		STPEnabled:      types.BoolValue(tools.StringToBool(d.STPEnabled)),
+		STPProtocol:     types.StringValue(d.STPProtocol.String()),
+		STPInterfaces:   tools.StringSliceToSet(d.STPInterfaces),
+		STPMaxAge:       types.Int64Value(tools.StringToInt64(d.STPMaxAge)),
+		STPForwardDelay: types.Int64Value(tools.StringToInt64(d.STPForwardDelay)),
+		STPHoldCount:    types.Int64Value(tools.StringToInt64(d.STPHoldCount)),
+		STPPriority:     types.Int64Value(tools.StringToInt64(d.STPPriority)),
+		SpanPorts:       tools.StringSliceToSet(d.SpanPorts),
+		LinkLocal:       types.BoolValue(tools.StringToBool(d.LinkLocal)),
+		Learning:        types.BoolValue(tools.StringToBool(d.Learning)),
+		Description:     tools.StringOrNull(d.Description),
+		Device:          types.StringValue(d.Device),
+	}, nil
+}
@@ -8,14 +8,29 @@ import (
 
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		newAssignmentResource,
+		newBridgeResource,
+		newGifResource,
+		newGreResource,
+		newLaggResource,
+		newLoopbackResource,
 		newVipResource,
 		newVlanResource,
+		newVxlanResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newAssignmentDataSource,
+		newBridgeDataSource,
+		newGifDataSource,
+		newGreDataSource,
+		newLaggDataSource,
+		newLoopbackDataSource,
+		newOverviewDataSource,
 		newVipDataSource,
 		newVlanDataSource,
+		newVxlanDataSource,
 	}
 }
@@ -0,0 +1,172 @@
+package interfaces
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gifResourceModel describes the resource data model.
+type gifResourceModel struct {
+	LocalAddress        types.String `tfsdk:"local_address"`
+	RemoteAddress       types.String `tfsdk:"remote_address"`
+	TunnelLocalAddress  types.String `tfsdk:"tunnel_local_address"`
+	TunnelRemoteAddress types.String `tfsdk:"tunnel_remote_address"`
+	TunnelRemoteNetmask types.Int64  `tfsdk:"tunnel_remote_netmask"`
+	IngressFiltering    types.Bool   `tfsdk:"ingress_filtering"`
+	EcnFriendly         types.Bool   `tfsdk:"ecn_friendly"`
+	Description         types.String `tfsdk:"description"`
+	Device              types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func gifResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "GIF (generic tunnel interface) tunnels carry IPv4 or IPv6 traffic inside IPv4 or IPv6 packets to a remote router.",
+
+		Attributes: map[string]schema.Attribute{
+			"local_address": schema.StringAttribute{
+				MarkdownDescription: "Parent interface or local address the tunnel originates from, e.g. `wan` or `203.0.113.10`.",
+				Required:            true,
+			},
+			"remote_address": schema.StringAttribute{
+				MarkdownDescription: "Public address of the remote tunnel endpoint.",
+				Required:            true,
+			},
+			"tunnel_local_address": schema.StringAttribute{
+				MarkdownDescription: "Local address inside the tunnel, e.g. `10.255.0.1`.",
+				Required:            true,
+			},
+			"tunnel_remote_address": schema.StringAttribute{
+				MarkdownDescription: "Remote address inside the tunnel, e.g. `10.255.0.2`.",
+				Required:            true,
+			},
+			"tunnel_remote_netmask": schema.Int64Attribute{
+				MarkdownDescription: "Netmask (prefix length) of the remote tunnel address. Defaults to `32`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(32),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+			},
+			"ingress_filtering": schema.BoolAttribute{
+				MarkdownDescription: "Drop packets arriving on the tunnel whose source address is not routed via the tunnel. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ecn_friendly": schema.BoolAttribute{
+				MarkdownDescription: "Copy the ECN bits between the inner and outer IP headers, as described in RFC 3168. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Name of the GIF device generated by OPNsense, e.g. `gif0`. Use this as the `device` of an interface assignment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the GIF tunnel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func gifDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "GIF (generic tunnel interface) tunnels carry IPv4 or IPv6 traffic inside IPv4 or IPv6 packets to a remote router.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"local_address": dschema.StringAttribute{
+				MarkdownDescription: "Parent interface or local address the tunnel originates from.",
+				Computed:            true,
+			},
+			"remote_address": dschema.StringAttribute{
+				MarkdownDescription: "Public address of the remote tunnel endpoint.",
+				Computed:            true,
+			},
+			"tunnel_local_address": dschema.StringAttribute{
+				MarkdownDescription: "Local address inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_remote_address": dschema.StringAttribute{
+				MarkdownDescription: "Remote address inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_remote_netmask": dschema.Int64Attribute{
+				MarkdownDescription: "Netmask (prefix length) of the remote tunnel address.",
+				Computed:            true,
+			},
+			"ingress_filtering": dschema.BoolAttribute{
+				MarkdownDescription: "Whether ingress filtering is enabled on the tunnel.",
+				Computed:            true,
+			},
+			"ecn_friendly": dschema.BoolAttribute{
+				MarkdownDescription: "Whether ECN bits are copied between the inner and outer IP headers.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "Name of the GIF device, e.g. `gif0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertGifSchemaToStruct(d *gifResourceModel) (*interfaces.Gif, error) {
+	return &interfaces.Gif{
+		LocalAddress:        api.SelectedMap(d.LocalAddress.ValueString()),
+		RemoteAddress:       d.RemoteAddress.ValueString(),
+		TunnelLocalAddress:  d.TunnelLocalAddress.ValueString(),
+		TunnelRemoteAddress: d.TunnelRemoteAddress.ValueString(),
+		TunnelRemoteNetmask: api.SelectedMap(tools.Int64ToString(d.TunnelRemoteNetmask.ValueInt64())),
+		IngressFiltering:    tools.BoolToString(d.IngressFiltering.ValueBool()),
+		EcnFriendly:         tools.BoolToString(d.EcnFriendly.ValueBool()),
+		Description:         d.Description.ValueString(),
+		Device:              d.Device.ValueString(),
+	}, nil
+}
+
+func convertGifStructToSchema(d *interfaces.Gif) (*gifResourceModel, error) {
+	return &gifResourceModel{
+		LocalAddress:        types.StringValue(d.LocalAddress.String()),
+		RemoteAddress:       types.StringValue(d.RemoteAddress),
+		TunnelLocalAddress:  types.StringValue(d.TunnelLocalAddress),
+		TunnelRemoteAddress: types.StringValue(d.TunnelRemoteAddress),
+		TunnelRemoteNetmask: types.Int64Value(tools.StringToInt64(d.TunnelRemoteNetmask.String())),
+		IngressFiltering:    types.BoolValue(tools.StringToBool(d.IngressFiltering)),
+		EcnFriendly:         types.BoolValue(tools.StringToBool(d.EcnFriendly)),
+		Description:         tools.StringOrNull(d.Description),
+		Device:              types.StringValue(d.Device),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesLaggResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLaggResourceConfig("LAGG test", "lacp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_lagg.test", "description", "LAGG test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_lagg.test", "protocol", "lacp"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_lagg.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_lagg.test", "mtu", "-1"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_lagg.test", "device", regexp.MustCompile(`^lagg\d+$`)),
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_lagg.test", "id"),
+					resource.TestCheckResourceAttrPair("opnsense_interfaces_vlan.test", "parent", "opnsense_interfaces_lagg.test", "device"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_lagg.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccLaggResourceConfig("Updated LAGG", "failover"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_lagg.test", "description", "Updated LAGG"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_lagg.test", "protocol", "failover"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccLaggResourceConfig(description string, protocol string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_lagg" "test" {
+  description = %[1]q
+  members     = ["vtnet1"]
+  protocol    = %[2]q
+}
+
+resource "opnsense_interfaces_vlan" "test" {
+  tag         = 220
+  description = "LAGG test vlan"
+  priority    = 0
+  parent      = opnsense_interfaces_lagg.test.device
+}
+`, description, protocol)
+}
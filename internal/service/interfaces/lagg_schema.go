@@ -0,0 +1,185 @@
+package interfaces
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// laggResourceModel describes the resource data model.
+type laggResourceModel struct {
+	Members         types.Set    `tfsdk:"members"`
+	Protocol        types.String `tfsdk:"protocol"`
+	LacpFastTimeout types.Bool   `tfsdk:"lacp_fast_timeout"`
+	LacpStrict      types.Bool   `tfsdk:"lacp_strict"`
+	HashLayers      types.Set    `tfsdk:"hash_layers"`
+	MTU             types.Int64  `tfsdk:"mtu"`
+	Description     types.String `tfsdk:"description"`
+	Device          types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func laggResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Link aggregation (LAGG) interfaces combine multiple physical ports into a single logical interface for failover or increased throughput.",
+
+		Attributes: map[string]schema.Attribute{
+			"members": schema.SetAttribute{
+				MarkdownDescription: "Set of member ports to aggregate, e.g. `[\"vtnet1\", \"vtnet2\"]`. Member ports must not be assigned to any other interface.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Aggregation protocol. Available values: `none`, `lacp`, `failover`, `loadbalance`, `roundrobin`. Defaults to `lacp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("lacp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "lacp", "failover", "loadbalance", "roundrobin"),
+				},
+			},
+			"lacp_fast_timeout": schema.BoolAttribute{
+				MarkdownDescription: "Use the fast LACP timeout (1 second) instead of the slow timeout (30 seconds). Only applies when `protocol = \"lacp\"`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"lacp_strict": schema.BoolAttribute{
+				MarkdownDescription: "Enable LACP strict mode, which only brings up member ports that have negotiated with the link partner. Only applies when `protocol = \"lacp\"`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"hash_layers": schema.SetAttribute{
+				MarkdownDescription: "Set of packet layers used to compute the outgoing port hash. Available values: `l2`, `l3`, `l4`. Set to `[]` to use the system default. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("l2", "l3", "l4")),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of the LAGG device. Set to `-1` to use the MTU of the member ports. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(576, 65535),
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Name of the LAGG device generated by OPNsense, e.g. `lagg0`. Use this as the `parent` of a VLAN or the `device` of an interface assignment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the LAGG.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func laggDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Link aggregation (LAGG) interfaces combine multiple physical ports into a single logical interface for failover or increased throughput.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"members": dschema.SetAttribute{
+				MarkdownDescription: "Set of member ports.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "Aggregation protocol.",
+				Computed:            true,
+			},
+			"lacp_fast_timeout": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the fast LACP timeout is used.",
+				Computed:            true,
+			},
+			"lacp_strict": dschema.BoolAttribute{
+				MarkdownDescription: "Whether LACP strict mode is enabled.",
+				Computed:            true,
+			},
+			"hash_layers": dschema.SetAttribute{
+				MarkdownDescription: "Set of packet layers used to compute the outgoing port hash.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"mtu": dschema.Int64Attribute{
+				MarkdownDescription: "MTU of the LAGG device. `-1` means the MTU of the member ports is used.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "Name of the LAGG device, e.g. `lagg0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertLaggSchemaToStruct(d *laggResourceModel) (*interfaces.Lagg, error) {
+	return &interfaces.Lagg{
+		Members:         api.SelectedMapList(tools.SetToStringSlice(d.Members)),
+		Protocol:        api.SelectedMap(d.Protocol.ValueString()),
+		LacpFastTimeout: tools.BoolToString(d.LacpFastTimeout.ValueBool()),
+		LacpStrict:      api.SelectedMap(tools.BoolToString(d.LacpStrict.ValueBool())),
+		HashLayers:      api.SelectedMapList(tools.SetToStringSlice(d.HashLayers)),
+		MTU:             tools.Int64ToStringNegative(d.MTU.ValueInt64()),
+		Description:     d.Description.ValueString(),
+		Device:          d.Device.ValueString(),
+	}, nil
+}
+
+func convertLaggStructToSchema(d *interfaces.Lagg) (*laggResourceModel, error) {
+	return &laggResourceModel{
+		Members:         tools.StringSliceToSet(d.Members),
+		Protocol:        types.StringValue(d.Protocol.String()),
+		LacpFastTimeout: types.BoolValue(tools.StringToBool(d.LacpFastTimeout)),
+		LacpStrict:      types.BoolValue(tools.StringToBool(d.LacpStrict.String())),
+		HashLayers:      tools.StringSliceToSet(d.HashLayers),
+		MTU:             types.Int64Value(tools.StringToInt64(d.MTU)),
+		Description:     tools.StringOrNull(d.Description),
+		Device:          types.StringValue(d.Device),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package interfaces
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// loopbackResourceModel describes the resource data model.
+type loopbackResourceModel struct {
+	Description types.String `tfsdk:"description"`
+	Device      types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func loopbackResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Loopback interfaces are always-up virtual interfaces, commonly used to hold router IDs and anycast addresses for dynamic routing.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Name of the loopback device generated by OPNsense, e.g. `lo1`. Use this as the `device` of an interface assignment to configure its addresses.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the loopback.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func loopbackDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Loopback interfaces are always-up virtual interfaces, commonly used to hold router IDs and anycast addresses for dynamic routing.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "Name of the loopback device, e.g. `lo1`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertLoopbackSchemaToStruct(d *loopbackResourceModel) (*interfaces.Loopback, error) {
+	return &interfaces.Loopback{
+		Description: d.Description.ValueString(),
+		Device:      d.Device.ValueString(),
+	}, nil
+}
+
+func convertLoopbackStructToSchema(d *interfaces.Loopback) (*loopbackResourceModel, error) {
+	return &loopbackResourceModel{
+		Description: tools.StringOrNull(d.Description),
+		Device:      types.StringValue(d.Device),
+	}, nil
+}
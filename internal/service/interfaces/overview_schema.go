@@ -0,0 +1,137 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type overviewDataSourceModel struct {
+	Interfaces types.List `tfsdk:"interfaces"`
+}
+
+type overviewInterfaceModel struct {
+	Identifier    types.String `tfsdk:"identifier"`
+	Description   types.String `tfsdk:"description"`
+	Device        types.String `tfsdk:"device"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	LinkState     types.String `tfsdk:"link_state"`
+	IPv4Addresses types.List   `tfsdk:"ipv4_addresses"`
+	IPv6Addresses types.List   `tfsdk:"ipv6_addresses"`
+	Gateways      types.List   `tfsdk:"gateways"`
+}
+
+var overviewInterfaceAttrTypes = map[string]attr.Type{
+	"identifier":  types.StringType,
+	"description": types.StringType,
+	"device":      types.StringType,
+	"enabled":     types.BoolType,
+	"link_state":  types.StringType,
+	"ipv4_addresses": types.ListType{
+		ElemType: types.StringType,
+	},
+	"ipv6_addresses": types.ListType{
+		ElemType: types.StringType,
+	},
+	"gateways": types.ListType{
+		ElemType: types.StringType,
+	},
+}
+
+func overviewDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "The interfaces overview maps the logical interface identifiers used throughout OPNsense (e.g. `lan`, `opt4`) to their descriptions, devices and runtime state.",
+
+		Attributes: map[string]dschema.Attribute{
+			"interfaces": dschema.ListNestedAttribute{
+				MarkdownDescription: "A list of all assigned interfaces.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"identifier": dschema.StringAttribute{
+							MarkdownDescription: "Logical identifier of the interface, e.g. `lan` or `opt4`. This is the value expected by the `interface` attributes of other resources.",
+							Computed:            true,
+						},
+						"description": dschema.StringAttribute{
+							MarkdownDescription: "Description of the interface, e.g. `DMZ`.",
+							Computed:            true,
+						},
+						"device": dschema.StringAttribute{
+							MarkdownDescription: "Device assigned to the interface, e.g. `vlan0.100`.",
+							Computed:            true,
+						},
+						"enabled": dschema.BoolAttribute{
+							MarkdownDescription: "Whether the interface is enabled.",
+							Computed:            true,
+						},
+						"link_state": dschema.StringAttribute{
+							MarkdownDescription: "Link state of the interface (e.g. `\"up\"`, `\"down\"`, `\"no carrier\"`).",
+							Computed:            true,
+						},
+						"ipv4_addresses": dschema.ListAttribute{
+							MarkdownDescription: "IPv4 addresses configured on the interface, with prefix length.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ipv6_addresses": dschema.ListAttribute{
+							MarkdownDescription: "IPv6 addresses configured on the interface, with prefix length.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"gateways": dschema.ListAttribute{
+							MarkdownDescription: "Gateways reachable through the interface.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertOverviewStructToSchema(d []interfaces.OverviewInterface) (*overviewDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	ifaces := []overviewInterfaceModel{}
+	for _, iface := range d {
+		ipv4, _ := types.ListValueFrom(context.Background(), types.StringType, nonNilStrings(iface.IPv4Addresses))
+		ipv6, _ := types.ListValueFrom(context.Background(), types.StringType, nonNilStrings(iface.IPv6Addresses))
+		gateways, _ := types.ListValueFrom(context.Background(), types.StringType, nonNilStrings(iface.Gateways))
+
+		ifaces = append(ifaces, overviewInterfaceModel{
+			Identifier:    types.StringValue(iface.Identifier),
+			Description:   types.StringValue(iface.Description),
+			Device:        types.StringValue(iface.Device),
+			Enabled:       types.BoolValue(iface.Enabled),
+			LinkState:     types.StringValue(iface.Status),
+			IPv4Addresses: ipv4,
+			IPv6Addresses: ipv6,
+			Gateways:      gateways,
+		})
+	}
+
+	v, diags := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(overviewInterfaceAttrTypes),
+		ifaces,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error converting interfaces: %v", diags)
+	}
+
+	return &overviewDataSourceModel{
+		Interfaces: v,
+	}, nil
+}
+
+// nonNilStrings returns an empty slice for nil, so lists are `[]` rather than `null`.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+package interfaces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func overviewTestList(values ...string) types.List {
+	v, _ := types.ListValueFrom(context.Background(), types.StringType, append([]string{}, values...))
+	return v
+}
+
+func TestConvertOverviewStructToSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []interfaces.OverviewInterface
+		expected []overviewInterfaceModel
+	}{
+		{
+			name:     "empty",
+			input:    []interfaces.OverviewInterface{},
+			expected: []overviewInterfaceModel{},
+		},
+		{
+			name: "addresses_and_gateways",
+			input: []interfaces.OverviewInterface{
+				{
+					Identifier:    "lan",
+					Description:   "LAN",
+					Device:        "igb1",
+					Enabled:       true,
+					Status:        "up",
+					IPv4Addresses: []string{"192.168.1.1/24"},
+					IPv6Addresses: []string{"2001:db8::1/64", "fe80::1/64"},
+					Gateways:      []string{"192.168.1.254"},
+				},
+			},
+			expected: []overviewInterfaceModel{
+				{
+					Identifier:    types.StringValue("lan"),
+					Description:   types.StringValue("LAN"),
+					Device:        types.StringValue("igb1"),
+					Enabled:       types.BoolValue(true),
+					LinkState:     types.StringValue("up"),
+					IPv4Addresses: overviewTestList("192.168.1.1/24"),
+					IPv6Addresses: overviewTestList("2001:db8::1/64", "fe80::1/64"),
+					Gateways:      overviewTestList("192.168.1.254"),
+				},
+			},
+		},
+		{
+			name: "missing_lists_are_empty",
+			input: []interfaces.OverviewInterface{
+				{
+					Identifier: "opt4",
+					Device:     "vlan0.100",
+					Status:     "no carrier",
+				},
+			},
+			expected: []overviewInterfaceModel{
+				{
+					Identifier:    types.StringValue("opt4"),
+					Description:   types.StringValue(""),
+					Device:        types.StringValue("vlan0.100"),
+					Enabled:       types.BoolValue(false),
+					LinkState:     types.StringValue("no carrier"),
+					IPv4Addresses: overviewTestList(),
+					IPv6Addresses: overviewTestList(),
+					Gateways:      overviewTestList(),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertOverviewStructToSchema(tt.input)
+			assert.NoError(t, err)
+
+			var ifaces []overviewInterfaceModel
+			assert.False(t, result.Interfaces.ElementsAs(context.Background(), &ifaces, false).HasError())
+			assert.Equal(t, tt.expected, ifaces)
+		})
+	}
+}
@@ -1,8 +1,8 @@
 package interfaces
 
 import (
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -56,7 +56,10 @@ func vipResourceSchema() schema.Schema {
 				MarkdownDescription: "For some interface types a gateway is required to configure an IP Alias (ppp/pppoe/tun), leave this field empty for all other interface types.",
 				Optional:            true,
 				Validators: []validator.String{
-					validators.IpOrCIDR(),
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						validators.IpOrCIDR(),
+					),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -63,6 +63,24 @@ func TestAccInterfacesVlanResource_HighVlanId(t *testing.T) {
 	})
 }
 
+func TestAccInterfacesVlanResource_QinQ(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanResourceConfigQinQ(200, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.service", "protocol", "802.1ad"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.customer", "protocol", "802.1q"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.customer", "tag", "20"),
+					resource.TestCheckResourceAttrPair("opnsense_interfaces_vlan.customer", "parent", "opnsense_interfaces_vlan.service", "device"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVlanResourceConfig(tag int, description string, priority int, parent string) string {
 	return fmt.Sprintf(`
 resource "opnsense_interfaces_vlan" "test" {
@@ -73,3 +91,22 @@ resource "opnsense_interfaces_vlan" "test" {
 }
 `, tag, description, priority, parent)
 }
+
+func testAccVlanResourceConfigQinQ(serviceTag int, customerTag int) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_vlan" "service" {
+  tag         = %[1]d
+  description = "QinQ service VLAN"
+  protocol    = "802.1ad"
+  parent      = "vtnet0"
+  device      = "vlan0.%[1]d"
+}
+
+resource "opnsense_interfaces_vlan" "customer" {
+  tag         = %[2]d
+  description = "QinQ customer VLAN"
+  parent      = opnsense_interfaces_vlan.service.device
+  device      = "qinq0.%[1]d.%[2]d"
+}
+`, serviceTag, customerTag)
+}
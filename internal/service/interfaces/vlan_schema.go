@@ -1,10 +1,11 @@
 package interfaces
 
 import (
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -21,6 +22,7 @@ type vlanResourceModel struct {
 	Tag         types.Int64  `tfsdk:"tag"`
 	Priority    types.Int64  `tfsdk:"priority"`
 	Parent      types.String `tfsdk:"parent"`
+	Protocol    types.String `tfsdk:"protocol"`
 	Device      types.String `tfsdk:"device"`
 
 	Id types.String `tfsdk:"id"`
@@ -52,9 +54,21 @@ func vlanResourceSchema() schema.Schema {
 				},
 			},
 			"parent": schema.StringAttribute{
-				MarkdownDescription: "VLAN capable interface to attach the VLAN to, e.g. `vtnet0`.",
+				MarkdownDescription: "VLAN capable interface to attach the VLAN to, e.g. `vtnet0` or the `device` of an `opnsense_interfaces_lagg`. For QinQ, set this to the `device` of the 802.1ad service VLAN.",
 				Required:            true,
 			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "VLAN protocol. Use `802.1ad` for the outer service VLAN of a QinQ stack. Available values: `802.1q`, `802.1ad`. Changing this forces a new resource to be created. Defaults to `802.1q`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("802.1q"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("802.1q", "802.1ad"),
+				},
+			},
 			"device": schema.StringAttribute{
 				MarkdownDescription: "Custom VLAN name. Custom names are possible, but only if the start of the name matches the required prefix and contains numeric characters or dots, e.g. `vlan0.1.2` or `qinq0.3.4`. Set to `\"\"` to generate a device name. Defaults to `\"\"`",
 				Optional:            true,
@@ -100,6 +114,10 @@ func vlanDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "VLAN capable interface to attach the VLAN to, e.g. `vtnet0`.",
 				Computed:            true,
 			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "VLAN protocol, either `802.1q` or `802.1ad`.",
+				Computed:            true,
+			},
 			"device": dschema.StringAttribute{
 				MarkdownDescription: "Custom VLAN name. Custom names are possible, but only if the start of the name matches the required prefix and contains numeric characters or dots, e.g. `vlan0.1.2` or `qinq0.3.4`.",
 				Computed:            true,
@@ -114,16 +132,24 @@ func convertVlanSchemaToStruct(d *vlanResourceModel) (*interfaces.Vlan, error) {
 		Tag:         tools.Int64ToString(d.Tag.ValueInt64()),
 		Priority:    api.SelectedMap(tools.Int64ToString(d.Priority.ValueInt64())),
 		Parent:      api.SelectedMap(d.Parent.ValueString()),
+		Protocol:    api.SelectedMap(d.Protocol.ValueString()),
 		Device:      d.Device.ValueString(),
 	}, nil
 }
 
 func convertVlanStructToSchema(d *interfaces.Vlan) (*vlanResourceModel, error) {
+	// VLANs created before the protocol could be selected are plain 802.1Q
+	protocol := d.Protocol.String()
+	if protocol == "" {
+		protocol = "802.1q"
+	}
+
 	return &vlanResourceModel{
 		Description: tools.StringOrNull(d.Description),
 		Tag:         tools.StringToInt64Null(d.Tag),
 		Priority:    tools.StringToInt64Null(d.Priority.String()),
 		Parent:      types.StringValue(d.Parent.String()),
+		Protocol:    types.StringValue(protocol),
 		Device:      types.StringValue(d.Device),
 	}, nil
 }
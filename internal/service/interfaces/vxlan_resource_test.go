@@ -0,0 +1,57 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesVxlanResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVxlanResourceConfig(10020, "198.51.100.40"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "vni", "10020"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "local_address", "203.0.113.10"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "remote_address", "198.51.100.40"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "multicast_group", ""),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "port", "-1"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_vxlan.test", "device", regexp.MustCompile(`^vxlan\d+$`)),
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_vxlan.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_vxlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVxlanResourceConfig(10021, "198.51.100.41"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "vni", "10021"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "remote_address", "198.51.100.41"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVxlanResourceConfig(vni int, remote string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_vxlan" "test" {
+  vni            = %[1]d
+  local_address  = "203.0.113.10"
+  remote_address = %[2]q
+}
+`, vni, remote)
+}
@@ -0,0 +1,170 @@
+package interfaces
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vxlanResourceModel describes the resource data model.
+type vxlanResourceModel struct {
+	VNI             types.Int64  `tfsdk:"vni"`
+	LocalAddress    types.String `tfsdk:"local_address"`
+	RemoteAddress   types.String `tfsdk:"remote_address"`
+	MulticastGroup  types.String `tfsdk:"multicast_group"`
+	MulticastDevice types.String `tfsdk:"multicast_device"`
+	Port            types.Int64  `tfsdk:"port"`
+	Device          types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func vxlanResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "VXLAN (Virtual eXtensible LAN) interfaces tunnel layer 2 traffic over a routed layer 3 network.",
+
+		Attributes: map[string]schema.Attribute{
+			"vni": schema.Int64Attribute{
+				MarkdownDescription: "VXLAN network identifier (VNI) of the virtual network segment.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 16777215),
+				},
+			},
+			"local_address": schema.StringAttribute{
+				MarkdownDescription: "Source address used for encapsulated packets, e.g. `203.0.113.10`.",
+				Required:            true,
+			},
+			"remote_address": schema.StringAttribute{
+				MarkdownDescription: "Address of the remote VXLAN tunnel endpoint for unicast operation. Exactly one of `remote_address` or `multicast_group` must be set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("multicast_group"),
+					}...),
+				},
+			},
+			"multicast_group": schema.StringAttribute{
+				MarkdownDescription: "Multicast group address to join for multicast operation. Exactly one of `remote_address` or `multicast_group` must be set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"multicast_device": schema.StringAttribute{
+				MarkdownDescription: "Device used to send and receive multicast traffic, e.g. `vtnet0`. Only applies when `multicast_group` is set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("multicast_group"),
+					}...),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "UDP port used for the encapsulated traffic. Set to `-1` to use the IANA assigned port (4789). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Name of the VXLAN device generated by OPNsense, e.g. `vxlan0`. Use this as a member of an `opnsense_interfaces_bridge` or the `device` of an interface assignment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the VXLAN.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func vxlanDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "VXLAN (Virtual eXtensible LAN) interfaces tunnel layer 2 traffic over a routed layer 3 network.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"vni": dschema.Int64Attribute{
+				MarkdownDescription: "VXLAN network identifier (VNI) of the virtual network segment.",
+				Computed:            true,
+			},
+			"local_address": dschema.StringAttribute{
+				MarkdownDescription: "Source address used for encapsulated packets.",
+				Computed:            true,
+			},
+			"remote_address": dschema.StringAttribute{
+				MarkdownDescription: "Address of the remote VXLAN tunnel endpoint.",
+				Computed:            true,
+			},
+			"multicast_group": dschema.StringAttribute{
+				MarkdownDescription: "Multicast group address.",
+				Computed:            true,
+			},
+			"multicast_device": dschema.StringAttribute{
+				MarkdownDescription: "Device used to send and receive multicast traffic.",
+				Computed:            true,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "UDP port used for the encapsulated traffic. `-1` means the IANA assigned port is used.",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "Name of the VXLAN device, e.g. `vxlan0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertVxlanSchemaToStruct(d *vxlanResourceModel) (*interfaces.Vxlan, error) {
+	return &interfaces.Vxlan{
+		VNI:             tools.Int64ToString(d.VNI.ValueInt64()),
+		LocalAddress:    d.LocalAddress.ValueString(),
+		RemoteAddress:   d.RemoteAddress.ValueString(),
+		MulticastGroup:  d.MulticastGroup.ValueString(),
+		MulticastDevice: api.SelectedMap(d.MulticastDevice.ValueString()),
+		Port:            tools.Int64ToStringNegative(d.Port.ValueInt64()),
+		Device:          d.Device.ValueString(),
+	}, nil
+}
+
+func convertVxlanStructToSchema(d *interfaces.Vxlan) (*vxlanResourceModel, error) {
+	return &vxlanResourceModel{
+		VNI:             tools.StringToInt64Null(d.VNI),
+		LocalAddress:    types.StringValue(d.LocalAddress),
+		RemoteAddress:   types.StringValue(d.RemoteAddress),
+		MulticastGroup:  types.StringValue(d.MulticastGroup),
+		MulticastDevice: types.StringValue(d.MulticastDevice.String()),
+		Port:            types.Int64Value(tools.StringToInt64(d.Port)),
+		Device:          types.StringValue(d.Device),
+	}, nil
+}
@@ -0,0 +1,195 @@
+package kea
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &dhcpv4SettingsResource{}
+var _ resource.ResourceWithConfigure = &dhcpv4SettingsResource{}
+var _ resource.ResourceWithValidateConfig = &dhcpv4SettingsResource{}
+
+func newDhcpv4SettingsResource() resource.Resource {
+	return &dhcpv4SettingsResource{}
+}
+
+// dhcpv4SettingsResource defines the resource implementation.
+type dhcpv4SettingsResource struct {
+	client opnsense.Client
+}
+
+func (r *dhcpv4SettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kea_dhcpv4_settings"
+}
+
+func (r *dhcpv4SettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dhcpv4SettingsResourceSchema()
+}
+
+func (r *dhcpv4SettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *dhcpv4SettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *dhcpv4SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.HAEnabled.IsUnknown() || data.ThisServerName.IsUnknown() {
+		return
+	}
+
+	// Kea picks its own peer entry by this name, without it HA cannot start
+	if data.HAEnabled.ValueBool() && data.ThisServerName.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("this_server_name"), "Missing Attribute Configuration",
+			"this_server_name must be set when ha_enabled is true, and match the name of one opnsense_kea_peer.")
+	}
+}
+
+func (r *dhcpv4SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *dhcpv4SettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	settings, err := convertDhcpv4SettingsSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	// Settings always exist in OPNsense, so creating is an update
+	err = r.client.Kea().UpdateDhcpv4Settings(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dhcpv4SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *dhcpv4SettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get DHCPv4 settings from OPNsense kea API
+	settings, err := r.client.Kea().GetDhcpv4Settings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	settingsModel, err := convertDhcpv4SettingsStructToSchema(settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsModel)...)
+}
+
+func (r *dhcpv4SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *dhcpv4SettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	settings, err := convertDhcpv4SettingsSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	// Update DHCPv4 settings in OPNsense kea
+	err = r.client.Kea().UpdateDhcpv4Settings(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dhcpv4SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *dhcpv4SettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings cannot be removed, so disable the DHCPv4 server instead
+	data.Enabled = types.BoolValue(false)
+
+	settings, err := convertDhcpv4SettingsSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse DHCPv4 settings, got error: %s", err))
+		return
+	}
+
+	err = r.client.Kea().UpdateDhcpv4Settings(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to disable DHCPv4 settings, got error: %s", err))
+		return
+	}
+}
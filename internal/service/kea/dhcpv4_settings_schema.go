@@ -0,0 +1,205 @@
+package kea
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/kea"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dhcpv4SettingsResourceModel describes the resource data model.
+type dhcpv4SettingsResourceModel struct {
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Interfaces       types.Set    `tfsdk:"interfaces"`
+	ValidLifetime    types.Int64  `tfsdk:"valid_lifetime"`
+	MaxLifetime      types.Int64  `tfsdk:"max_lifetime"`
+	SocketType       types.String `tfsdk:"socket_type"`
+	FirewallRules    types.Bool   `tfsdk:"firewall_rules"`
+	LeaseExpireSweep types.Int64  `tfsdk:"lease_expire_sweep"`
+
+	HAEnabled         types.Bool   `tfsdk:"ha_enabled"`
+	ThisServerName    types.String `tfsdk:"this_server_name"`
+	MaxUnackedClients types.Int64  `tfsdk:"max_unacked_clients"`
+}
+
+func dhcpv4SettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the global settings of the Kea DHCPv4 server. This is a singleton, only one instance of this resource should exist. Destroying it disables the DHCPv4 server.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the DHCPv4 server. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces the DHCPv4 server listens on, e.g. `lan`. Defaults to `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Default lifetime of a lease in seconds. Defaults to `4000`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(4000),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Maximum lifetime of a lease in seconds, when a client asks for a longer lease. Set to `-1` to use `valid_lifetime`. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.AtLeast(1),
+					),
+				},
+			},
+			"socket_type": schema.StringAttribute{
+				MarkdownDescription: "Socket type used to receive DHCP traffic. Use `udp` when only relayed traffic is served. Available values: `raw`, `udp`. Defaults to `\"raw\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("raw"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("raw", "udp"),
+				},
+			},
+			"firewall_rules": schema.BoolAttribute{
+				MarkdownDescription: "Automatically add firewall rules allowing DHCP traffic on the listen interfaces. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"lease_expire_sweep": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between runs that reclaim expired leases. Set to `-1` to use the Kea default of `10`. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.AtLeast(1),
+					),
+				},
+			},
+			"ha_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable high availability using the `opnsense_kea_peer` entries. Requires `this_server_name`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"this_server_name": schema.StringAttribute{
+				MarkdownDescription: "Name of this machine, which must match the name of one `opnsense_kea_peer`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"max_unacked_clients": schema.Int64Attribute{
+				MarkdownDescription: "Number of clients the partner may leave unanswered before this server considers it down. Set to `0` to fail over as soon as the partner stops responding. Defaults to `2`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(2),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func dhcpv4SettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the global settings of the Kea DHCPv4 server.",
+
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the DHCPv4 server is enabled.",
+				Computed:            true,
+			},
+			"interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces the DHCPv4 server listens on.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"valid_lifetime": dschema.Int64Attribute{
+				MarkdownDescription: "Default lifetime of a lease in seconds.",
+				Computed:            true,
+			},
+			"max_lifetime": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum lifetime of a lease in seconds, or `-1` when `valid_lifetime` is used.",
+				Computed:            true,
+			},
+			"socket_type": dschema.StringAttribute{
+				MarkdownDescription: "Socket type used to receive DHCP traffic.",
+				Computed:            true,
+			},
+			"firewall_rules": dschema.BoolAttribute{
+				MarkdownDescription: "Whether firewall rules for DHCP traffic are added automatically.",
+				Computed:            true,
+			},
+			"lease_expire_sweep": dschema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between runs that reclaim expired leases, or `-1` for the Kea default.",
+				Computed:            true,
+			},
+			"ha_enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether high availability is enabled.",
+				Computed:            true,
+			},
+			"this_server_name": dschema.StringAttribute{
+				MarkdownDescription: "Name of this machine in the HA peer list.",
+				Computed:            true,
+			},
+			"max_unacked_clients": dschema.Int64Attribute{
+				MarkdownDescription: "Number of clients the partner may leave unanswered before this server considers it down.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDhcpv4SettingsSchemaToStruct(d *dhcpv4SettingsResourceModel) (*kea.Dhcpv4Settings, error) {
+	return &kea.Dhcpv4Settings{
+		Enabled:          tools.BoolToString(d.Enabled.ValueBool()),
+		Interfaces:       api.SelectedMapList(tools.SetToStringSlice(d.Interfaces)),
+		ValidLifetime:    tools.Int64ToString(d.ValidLifetime.ValueInt64()),
+		MaxLifetime:      tools.Int64ToStringNegative(d.MaxLifetime.ValueInt64()),
+		SocketType:       api.SelectedMap(d.SocketType.ValueString()),
+		FirewallRules:    tools.BoolToString(d.FirewallRules.ValueBool()),
+		LeaseExpireSweep: tools.Int64ToStringNegative(d.LeaseExpireSweep.ValueInt64()),
+
+		HAEnabled:         tools.BoolToString(d.HAEnabled.ValueBool()),
+		ThisServerName:    d.ThisServerName.ValueString(),
+		MaxUnackedClients: tools.Int64ToString(d.MaxUnackedClients.ValueInt64()),
+	}, nil
+}
+
+func convertDhcpv4SettingsStructToSchema(d *kea.Dhcpv4Settings) (*dhcpv4SettingsResourceModel, error) {
+	return &dhcpv4SettingsResourceModel{
+		Enabled:          types.BoolValue(tools.StringToBool(d.Enabled)),
+		Interfaces:       tools.StringSliceToSet(d.Interfaces),
+		ValidLifetime:    types.Int64Value(tools.StringToInt64(d.ValidLifetime)),
+		MaxLifetime:      types.Int64Value(tools.StringToInt64(d.MaxLifetime)),
+		SocketType:       types.StringValue(d.SocketType.String()),
+		FirewallRules:    types.BoolValue(tools.StringToBool(d.FirewallRules)),
+		LeaseExpireSweep: types.Int64Value(tools.StringToInt64(d.LeaseExpireSweep)),
+
+		HAEnabled:         types.BoolValue(tools.StringToBool(d.HAEnabled)),
+		ThisServerName:    types.StringValue(d.ThisServerName),
+		MaxUnackedClients: types.Int64Value(tools.StringToInt64(d.MaxUnackedClients)),
+	}, nil
+}
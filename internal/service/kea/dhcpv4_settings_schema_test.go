@@ -0,0 +1,168 @@
+package kea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/kea"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertDhcpv4SettingsSchemaToStruct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *dhcpv4SettingsResourceModel
+		expected *kea.Dhcpv4Settings
+	}{
+		{
+			name: "defaults",
+			input: &dhcpv4SettingsResourceModel{
+				Enabled:           types.BoolValue(true),
+				Interfaces:        tools.StringSliceToSet([]string{}),
+				ValidLifetime:     types.Int64Value(4000),
+				MaxLifetime:       types.Int64Value(-1),
+				SocketType:        types.StringValue("raw"),
+				FirewallRules:     types.BoolValue(true),
+				LeaseExpireSweep:  types.Int64Value(-1),
+				HAEnabled:         types.BoolValue(false),
+				ThisServerName:    types.StringValue(""),
+				MaxUnackedClients: types.Int64Value(2),
+			},
+			expected: &kea.Dhcpv4Settings{
+				Enabled:           "1",
+				Interfaces:        api.SelectedMapList{},
+				ValidLifetime:     "4000",
+				MaxLifetime:       "",
+				SocketType:        api.SelectedMap("raw"),
+				FirewallRules:     "1",
+				LeaseExpireSweep:  "",
+				HAEnabled:         "0",
+				ThisServerName:    "",
+				MaxUnackedClients: "2",
+			},
+		},
+		{
+			name: "explicit_lifetimes_and_ha",
+			input: &dhcpv4SettingsResourceModel{
+				Enabled:           types.BoolValue(true),
+				Interfaces:        tools.StringSliceToSet([]string{"lan", "opt1"}),
+				ValidLifetime:     types.Int64Value(3600),
+				MaxLifetime:       types.Int64Value(7200),
+				SocketType:        types.StringValue("udp"),
+				FirewallRules:     types.BoolValue(false),
+				LeaseExpireSweep:  types.Int64Value(30),
+				HAEnabled:         types.BoolValue(true),
+				ThisServerName:    types.StringValue("fw1"),
+				MaxUnackedClients: types.Int64Value(0),
+			},
+			expected: &kea.Dhcpv4Settings{
+				Enabled:           "1",
+				Interfaces:        api.SelectedMapList{"lan", "opt1"},
+				ValidLifetime:     "3600",
+				MaxLifetime:       "7200",
+				SocketType:        api.SelectedMap("udp"),
+				FirewallRules:     "0",
+				LeaseExpireSweep:  "30",
+				HAEnabled:         "1",
+				ThisServerName:    "fw1",
+				MaxUnackedClients: "0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertDhcpv4SettingsSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestConvertDhcpv4SettingsStructToSchema(t *testing.T) {
+	result, err := convertDhcpv4SettingsStructToSchema(&kea.Dhcpv4Settings{
+		Enabled:           "0",
+		Interfaces:        api.SelectedMapList{"lan"},
+		ValidLifetime:     "4000",
+		MaxLifetime:       "",
+		SocketType:        api.SelectedMap("raw"),
+		FirewallRules:     "1",
+		LeaseExpireSweep:  "",
+		HAEnabled:         "0",
+		ThisServerName:    "",
+		MaxUnackedClients: "2",
+	})
+	assert.NoError(t, err)
+
+	// Empty lifetimes are read back as -1, so the defaults do not cause a diff
+	assert.Equal(t, types.Int64Value(-1), result.MaxLifetime)
+	assert.Equal(t, types.Int64Value(-1), result.LeaseExpireSweep)
+	assert.Equal(t, types.Int64Value(4000), result.ValidLifetime)
+	assert.Equal(t, types.BoolValue(false), result.Enabled)
+	assert.Equal(t, tools.StringSliceToSet([]string{"lan"}), result.Interfaces)
+}
+
+func TestConvertDhcpv4SettingsRoundTrip(t *testing.T) {
+	original := &dhcpv4SettingsResourceModel{
+		Enabled:           types.BoolValue(true),
+		Interfaces:        tools.StringSliceToSet([]string{"lan"}),
+		ValidLifetime:     types.Int64Value(86400),
+		MaxLifetime:       types.Int64Value(-1),
+		SocketType:        types.StringValue("raw"),
+		FirewallRules:     types.BoolValue(true),
+		LeaseExpireSweep:  types.Int64Value(60),
+		HAEnabled:         types.BoolValue(false),
+		ThisServerName:    types.StringValue(""),
+		MaxUnackedClients: types.Int64Value(2),
+	}
+
+	settings, err := convertDhcpv4SettingsSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertDhcpv4SettingsStructToSchema(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestDhcpv4SettingsSchemaValidation(t *testing.T) {
+	attributes := dhcpv4SettingsResourceSchema().Attributes
+
+	tests := []struct {
+		attribute string
+		value     int64
+		valid     bool
+	}{
+		{attribute: "valid_lifetime", value: 1, valid: true},
+		{attribute: "valid_lifetime", value: 0, valid: false},
+		{attribute: "max_lifetime", value: -1, valid: true},
+		{attribute: "max_lifetime", value: 7200, valid: true},
+		{attribute: "max_lifetime", value: 0, valid: false},
+		{attribute: "lease_expire_sweep", value: -1, valid: true},
+		{attribute: "lease_expire_sweep", value: -2, valid: false},
+		{attribute: "max_unacked_clients", value: 0, valid: true},
+		{attribute: "max_unacked_clients", value: -1, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute, func(t *testing.T) {
+			attr, ok := attributes[tt.attribute].(schema.Int64Attribute)
+			require.True(t, ok)
+
+			resp := &validator.Int64Response{}
+			for _, v := range attr.Validators {
+				v.ValidateInt64(context.Background(), validator.Int64Request{
+					Path:        path.Root(tt.attribute),
+					ConfigValue: types.Int64Value(tt.value),
+				}, resp)
+			}
+			assert.Equal(t, tt.valid, !resp.Diagnostics.HasError(), tt.value)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		newDhcpv4SettingsResource,
 		newPeerResource,
 		newReservationResource,
 		newSubnetResource,
@@ -17,6 +18,7 @@ func Resources(ctx context.Context) []func() resource.Resource {
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newDhcpv4SettingsDataSource,
 		newPeerDataSource,
 		newReservationDataSource,
 		newSubnetDataSource,
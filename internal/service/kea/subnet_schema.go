@@ -5,7 +5,7 @@ import (
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"strings"
 
-	"github.com/browningluke/opnsense-go/pkg/kea"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/kea"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -0,0 +1,214 @@
+package quagga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &bfdPeerResource{}
+var _ resource.ResourceWithConfigure = &bfdPeerResource{}
+var _ resource.ResourceWithImportState = &bfdPeerResource{}
+var _ resource.ResourceWithValidateConfig = &bfdPeerResource{}
+
+func newBFDPeerResource() resource.Resource {
+	return &bfdPeerResource{}
+}
+
+// bfdPeerResource defines the resource implementation.
+type bfdPeerResource struct {
+	client opnsense.Client
+}
+
+func (r *bfdPeerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bfd_peer"
+}
+
+func (r *bfdPeerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = bfdPeerResourceSchema()
+}
+
+func (r *bfdPeerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *bfdPeerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *bfdPeerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.Multihop.IsUnknown() || data.LocalAddress.IsUnknown() || data.EchoMode.IsUnknown() {
+		return
+	}
+
+	if !data.Multihop.ValueBool() {
+		return
+	}
+
+	// FRR only accepts multihop peers with a fixed source address, and echo mode only works on a single hop
+	if data.LocalAddress.IsNull() || data.LocalAddress.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("local_address"), "Missing Attribute Configuration",
+			"local_address must be set for a multihop BFD peer.")
+	}
+	if data.EchoMode.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("echo_mode"), "Invalid Attribute Combination",
+			"echo_mode cannot be enabled for a multihop BFD peer.")
+	}
+}
+
+func (r *bfdPeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *bfdPeerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	bfdPeer, err := convertBFDPeerSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bfd peer, got error: %s", err))
+		return
+	}
+
+	// Add bfd peer to OPNsense quagga
+	id, err := r.client.Quagga().AddBFDPeer(ctx, bfdPeer)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create bfd peer, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bfdPeerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *bfdPeerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get bfd peer from OPNsense quagga API
+	bfdPeer, err := r.client.Quagga().GetBFDPeer(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("bfd peer not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bfd peer, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	bfdPeerModel, err := convertBFDPeerStructToSchema(bfdPeer)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bfd peer, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	bfdPeerModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bfdPeerModel)...)
+}
+
+func (r *bfdPeerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *bfdPeerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	bfdPeer, err := convertBFDPeerSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bfd peer, got error: %s", err))
+		return
+	}
+
+	// Update bfd peer in OPNsense quagga
+	err = r.client.Quagga().UpdateBFDPeer(ctx, data.Id.ValueString(), bfdPeer)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update bfd peer, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bfdPeerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *bfdPeerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Quagga().DeleteBFDPeer(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete bfd peer, got error: %s", err))
+		return
+	}
+}
+
+func (r *bfdPeerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
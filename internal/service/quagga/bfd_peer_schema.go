@@ -0,0 +1,226 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bfdPeerResourceModel describes the resource data model.
+type bfdPeerResourceModel struct {
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Description      types.String `tfsdk:"description"`
+	Address          types.String `tfsdk:"address"`
+	Interface        types.String `tfsdk:"interface"`
+	LocalAddress     types.String `tfsdk:"local_address"`
+	Multihop         types.Bool   `tfsdk:"multihop"`
+	DetectMultiplier types.Int64  `tfsdk:"detect_multiplier"`
+	ReceiveInterval  types.Int64  `tfsdk:"receive_interval"`
+	TransmitInterval types.Int64  `tfsdk:"transmit_interval"`
+	EchoMode         types.Bool   `tfsdk:"echo_mode"`
+	EchoInterval     types.Int64  `tfsdk:"echo_interval"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func bfdPeerResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure BFD peers. BGP neighbors with `bfd` enabled use the peer with the same address.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this BFD peer. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this BFD peer. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the peer.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface facing the peer. Must be a valid OPNsense interface in lowercase (e.g. `wan`). Leave empty to use any interface. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"local_address": schema.StringAttribute{
+				MarkdownDescription: "The local IP address used to talk to the peer. Required when `multihop` is enabled. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						validators.IP(),
+					),
+				},
+			},
+			"multihop": schema.BoolAttribute{
+				MarkdownDescription: "Allow the peer to be more than one hop away. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"detect_multiplier": schema.Int64Attribute{
+				MarkdownDescription: "The number of missed packets before the session is considered down. Defaults to `3`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3),
+				Validators: []validator.Int64{
+					int64validator.Between(2, 255),
+				},
+			},
+			"receive_interval": schema.Int64Attribute{
+				MarkdownDescription: "The minimum interval in milliseconds at which this system can receive control packets. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.Between(10, 60000),
+				},
+			},
+			"transmit_interval": schema.Int64Attribute{
+				MarkdownDescription: "The minimum interval in milliseconds at which this system wants to send control packets. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.Between(10, 60000),
+				},
+			},
+			"echo_mode": schema.BoolAttribute{
+				MarkdownDescription: "Enable echo mode. Not supported for `multihop` peers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"echo_interval": schema.Int64Attribute{
+				MarkdownDescription: "The minimum interval in milliseconds at which this system can receive echo packets. Defaults to `50`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(50),
+				Validators: []validator.Int64{
+					int64validator.Between(10, 60000),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the BFD peer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func bfdPeerDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure BFD peers.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Enable this BFD peer.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this BFD peer.",
+				Computed:            true,
+			},
+			"address": dschema.StringAttribute{
+				MarkdownDescription: "The IP address of the peer.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface facing the peer.",
+				Computed:            true,
+			},
+			"local_address": dschema.StringAttribute{
+				MarkdownDescription: "The local IP address used to talk to the peer.",
+				Computed:            true,
+			},
+			"multihop": dschema.BoolAttribute{
+				MarkdownDescription: "Allow the peer to be more than one hop away.",
+				Computed:            true,
+			},
+			"detect_multiplier": dschema.Int64Attribute{
+				MarkdownDescription: "The number of missed packets before the session is considered down.",
+				Computed:            true,
+			},
+			"receive_interval": dschema.Int64Attribute{
+				MarkdownDescription: "The minimum interval in milliseconds at which this system can receive control packets.",
+				Computed:            true,
+			},
+			"transmit_interval": dschema.Int64Attribute{
+				MarkdownDescription: "The minimum interval in milliseconds at which this system wants to send control packets.",
+				Computed:            true,
+			},
+			"echo_mode": dschema.BoolAttribute{
+				MarkdownDescription: "Enable echo mode.",
+				Computed:            true,
+			},
+			"echo_interval": dschema.Int64Attribute{
+				MarkdownDescription: "The minimum interval in milliseconds at which this system can receive echo packets.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertBFDPeerSchemaToStruct(d *bfdPeerResourceModel) (*quagga.BFDPeer, error) {
+	return &quagga.BFDPeer{
+		Enabled:          tools.BoolToString(d.Enabled.ValueBool()),
+		Description:      d.Description.ValueString(),
+		Address:          d.Address.ValueString(),
+		Interface:        api.SelectedMap(d.Interface.ValueString()),
+		LocalAddress:     d.LocalAddress.ValueString(),
+		Multihop:         tools.BoolToString(d.Multihop.ValueBool()),
+		DetectMultiplier: tools.Int64ToString(d.DetectMultiplier.ValueInt64()),
+		ReceiveInterval:  tools.Int64ToString(d.ReceiveInterval.ValueInt64()),
+		TransmitInterval: tools.Int64ToString(d.TransmitInterval.ValueInt64()),
+		EchoMode:         tools.BoolToString(d.EchoMode.ValueBool()),
+		EchoInterval:     tools.Int64ToString(d.EchoInterval.ValueInt64()),
+	}, nil
+}
+
+func convertBFDPeerStructToSchema(d *quagga.BFDPeer) (*bfdPeerResourceModel, error) {
+	return &bfdPeerResourceModel{
+		Enabled:          types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:      types.StringValue(d.Description),
+		Address:          types.StringValue(d.Address),
+		Interface:        types.StringValue(d.Interface.String()),
+		LocalAddress:     types.StringValue(d.LocalAddress),
+		Multihop:         types.BoolValue(tools.StringToBool(d.Multihop)),
+		DetectMultiplier: types.Int64Value(tools.StringToInt64(d.DetectMultiplier)),
+		ReceiveInterval:  types.Int64Value(tools.StringToInt64(d.ReceiveInterval)),
+		TransmitInterval: types.Int64Value(tools.StringToInt64(d.TransmitInterval)),
+		EchoMode:         types.BoolValue(tools.StringToBool(d.EchoMode)),
+		EchoInterval:     types.Int64Value(tools.StringToInt64(d.EchoInterval)),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertBFDPeerSchemaToStruct(t *testing.T) {
+	input := &bfdPeerResourceModel{
+		Enabled:          types.BoolValue(true),
+		Description:      types.StringValue("upstream"),
+		Address:          types.StringValue("192.0.2.1"),
+		Interface:        types.StringValue("wan"),
+		LocalAddress:     types.StringValue(""),
+		Multihop:         types.BoolValue(false),
+		DetectMultiplier: types.Int64Value(3),
+		ReceiveInterval:  types.Int64Value(300),
+		TransmitInterval: types.Int64Value(300),
+		EchoMode:         types.BoolValue(true),
+		EchoInterval:     types.Int64Value(50),
+		Id:               types.StringValue("peer-id"),
+	}
+
+	expected := &quagga.BFDPeer{
+		Enabled:          "1",
+		Description:      "upstream",
+		Address:          "192.0.2.1",
+		Interface:        api.SelectedMap("wan"),
+		LocalAddress:     "",
+		Multihop:         "0",
+		DetectMultiplier: "3",
+		ReceiveInterval:  "300",
+		TransmitInterval: "300",
+		EchoMode:         "1",
+		EchoInterval:     "50",
+	}
+
+	result, err := convertBFDPeerSchemaToStruct(input)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestConvertBFDPeerRoundTrip(t *testing.T) {
+	original := &bfdPeerResourceModel{
+		Enabled:          types.BoolValue(true),
+		Description:      types.StringValue("remote site"),
+		Address:          types.StringValue("2001:db8::1"),
+		Interface:        types.StringValue(""),
+		LocalAddress:     types.StringValue("2001:db8::2"),
+		Multihop:         types.BoolValue(true),
+		DetectMultiplier: types.Int64Value(5),
+		ReceiveInterval:  types.Int64Value(1000),
+		TransmitInterval: types.Int64Value(1000),
+		EchoMode:         types.BoolValue(false),
+		EchoInterval:     types.Int64Value(50),
+	}
+
+	peer, err := convertBFDPeerSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertBFDPeerStructToSchema(peer)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestBFDPeerSchemaValidation(t *testing.T) {
+	schema := bfdPeerResourceSchema()
+
+	assert.True(t, schema.Attributes["address"].IsRequired())
+	assert.True(t, schema.Attributes["id"].IsComputed())
+
+	tests := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{attribute: "address", value: "192.0.2.1", valid: true},
+		{attribute: "address", value: "2001:db8::1", valid: true},
+		{attribute: "address", value: "peer.example.com", valid: false},
+		{attribute: "local_address", value: "", valid: true},
+		{attribute: "local_address", value: "192.0.2.2", valid: true},
+		{attribute: "local_address", value: "192.0.2.0/24", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"_"+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes[tt.attribute], tt.value))
+		})
+	}
+}
@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +18,8 @@ import (
 var _ resource.Resource = &bgpNeighborResource{}
 var _ resource.ResourceWithConfigure = &bgpNeighborResource{}
 var _ resource.ResourceWithImportState = &bgpNeighborResource{}
+var _ resource.ResourceWithValidateConfig = &bgpNeighborResource{}
+var _ resource.ResourceWithModifyPlan = &bgpNeighborResource{}
 
 func newBGPNeighborResource() resource.Resource {
 	return &bgpNeighborResource{}
@@ -54,6 +56,88 @@ func (r *bgpNeighborResource) Configure(ctx context.Context, req resource.Config
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *bgpNeighborResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *bgpNeighborResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.RemoteAS.IsUnknown() || data.PeerGroup.IsUnknown() {
+		return
+	}
+
+	// The remote AS must come from either the neighbor or its peer group
+	if !bgpNeighborHasRemoteAS(data) {
+		resp.Diagnostics.AddAttributeError(path.Root("remote_as"), "Missing Attribute Configuration",
+			"Either remote_as or peer_group must be set for a BGP neighbor.")
+	}
+}
+
+func (r *bgpNeighborResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var peerIP types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("peer_ip"), &peerIP)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Prior activate values only carry over while the peer stays in the same address family
+	keepPrior := false
+	if !req.State.Raw.IsNull() && !peerIP.IsUnknown() {
+		var priorPeerIP types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("peer_ip"), &priorPeerIP)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		keepPrior = bgpNeighborSameAddressFamily(priorPeerIP.ValueString(), peerIP.ValueString())
+	}
+
+	for _, family := range []string{"ipv4_unicast", "ipv6_unicast"} {
+		activatePath := path.Root(family).AtName("activate")
+
+		var activate types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, activatePath, &activate)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Set explicitly in the configuration
+		if !activate.IsNull() && !activate.IsUnknown() {
+			continue
+		}
+
+		// Existing neighbors keep the value stored in OPNsense, so upgrading does not
+		// turn an address family on or off
+		if keepPrior {
+			var prior types.Bool
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, activatePath, &prior)...)
+
+			if !prior.IsNull() && !prior.IsUnknown() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, activatePath, prior)...)
+				continue
+			}
+		}
+
+		// New neighbors, and neighbors moved to another address family, only exchange
+		// routes of the address family of the peer
+		if peerIP.IsUnknown() {
+			continue
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, activatePath, types.BoolValue(bgpNeighborDefaultActivate(peerIP.ValueString(), family)))...)
+	}
+}
+
 func (r *bgpNeighborResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *bgpNeighborResourceModel
 
@@ -64,6 +148,9 @@ func (r *bgpNeighborResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Address families left to the peer address are only known once it is
+	resolveBGPNeighborActivate(data)
+
 	// Convert TF schema OPNsense struct
 	bgpNeighbor, err := convertBGPNeighborSchemaToStruct(data)
 	if err != nil {
@@ -140,6 +227,9 @@ func (r *bgpNeighborResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Address families left to the peer address are only known once it is
+	resolveBGPNeighborActivate(data)
+
 	// Convert TF schema OPNsense struct
 	bgpNeighbor, err := convertBGPNeighborSchemaToStruct(data)
 	if err != nil {
@@ -1,14 +1,20 @@
 package quagga
 
 import (
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"fmt"
+	"net"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -16,6 +22,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// bgpNeighborAddressFamily describes the per address-family settings of a neighbor.
+type bgpNeighborAddressFamily struct {
+	Activate                   types.Bool   `tfsdk:"activate"`
+	AllowASIn                  types.Int64  `tfsdk:"allowas_in"`
+	MaximumPrefix              types.Int64  `tfsdk:"maximum_prefix"`
+	MaximumPrefixRestart       types.Int64  `tfsdk:"maximum_prefix_restart"`
+	MaximumPrefixWarningOnly   types.Bool   `tfsdk:"maximum_prefix_warning_only"`
+	SoftReconfigurationInbound types.Bool   `tfsdk:"soft_reconfiguration_inbound"`
+	SendCommunity              types.String `tfsdk:"send_community"`
+}
+
 // bgpNeighborResourceModel describes the resource data model.
 type bgpNeighborResourceModel struct {
 	Enabled               types.Bool   `tfsdk:"enabled"`
@@ -44,10 +61,148 @@ type bgpNeighborResourceModel struct {
 	PrefixListOut         types.String `tfsdk:"prefix_list_out"`
 	RouteMapIn            types.String `tfsdk:"route_map_in"`
 	RouteMapOut           types.String `tfsdk:"route_map_out"`
+	PeerGroup             types.String `tfsdk:"peer_group"`
+	Shutdown              types.Bool   `tfsdk:"shutdown"`
+
+	IPv4Unicast *bgpNeighborAddressFamily `tfsdk:"ipv4_unicast"`
+	IPv6Unicast *bgpNeighborAddressFamily `tfsdk:"ipv6_unicast"`
 
 	Id types.String `tfsdk:"id"`
 }
 
+func bgpNeighborAddressFamilyResourceAttribute(family string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Settings for the %s unicast address family.", family),
+		Optional:            true,
+		Computed:            true,
+		Default: objectdefault.StaticValue(
+			types.ObjectValueMust(
+				bgpNeighborAddressFamilyAttrTypes,
+				map[string]attr.Value{
+					"activate":                     types.BoolNull(),
+					"allowas_in":                   types.Int64Value(-1),
+					"maximum_prefix":               types.Int64Value(-1),
+					"maximum_prefix_restart":       types.Int64Value(-1),
+					"maximum_prefix_warning_only":  types.BoolValue(false),
+					"soft_reconfiguration_inbound": types.BoolValue(false),
+					"send_community":               types.StringValue(""),
+				},
+			),
+		),
+		Attributes: map[string]schema.Attribute{
+			"activate": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Exchange %s unicast routes with this neighbor. When not set, existing neighbors keep the value stored in OPNsense, and new neighbors default to `true` only if `peer_ip` is an %s address.", family, family),
+				Optional:            true,
+				Computed:            true,
+			},
+			"allowas_in": schema.Int64Attribute{
+				MarkdownDescription: "Accept routes containing the local AS up to this many times in the AS path. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 10),
+					),
+				},
+			},
+			"maximum_prefix": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of prefixes accepted from this neighbor before the session is torn down. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 4294967295),
+					),
+				},
+			},
+			"maximum_prefix_restart": schema.Int64Attribute{
+				MarkdownDescription: "Minutes after which a session torn down by `maximum_prefix` is restarted. Set to `-1` to keep it down until cleared manually. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"maximum_prefix_warning_only": schema.BoolAttribute{
+				MarkdownDescription: "Only log a warning when `maximum_prefix` is exceeded instead of tearing down the session. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"soft_reconfiguration_inbound": schema.BoolAttribute{
+				MarkdownDescription: "Store received routes so inbound policy changes can be applied without resetting the session. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"send_community": schema.StringAttribute{
+				MarkdownDescription: "Which communities to send to this neighbor. Set to `\"\"` to use the FRR default. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "standard", "extended", "large", "both", "all"),
+				},
+			},
+		},
+	}
+}
+
+func bgpNeighborAddressFamilyDataSourceAttribute(family string) dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Settings for the %s unicast address family.", family),
+		Computed:            true,
+		Attributes: map[string]dschema.Attribute{
+			"activate": dschema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Whether %s unicast routes are exchanged with this neighbor.", family),
+				Computed:            true,
+			},
+			"allowas_in": dschema.Int64Attribute{
+				MarkdownDescription: "How many times the local AS is accepted in the AS path, or `-1` if disabled.",
+				Computed:            true,
+			},
+			"maximum_prefix": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of prefixes accepted from this neighbor, or `-1` if disabled.",
+				Computed:            true,
+			},
+			"maximum_prefix_restart": dschema.Int64Attribute{
+				MarkdownDescription: "Minutes after which a session torn down by `maximum_prefix` is restarted, or `-1` if disabled.",
+				Computed:            true,
+			},
+			"maximum_prefix_warning_only": dschema.BoolAttribute{
+				MarkdownDescription: "Whether exceeding `maximum_prefix` only logs a warning.",
+				Computed:            true,
+			},
+			"soft_reconfiguration_inbound": dschema.BoolAttribute{
+				MarkdownDescription: "Whether received routes are stored for soft reconfiguration.",
+				Computed:            true,
+			},
+			"send_community": dschema.StringAttribute{
+				MarkdownDescription: "Which communities are sent to this neighbor.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+var bgpNeighborAddressFamilyAttrTypes = map[string]attr.Type{
+	"activate":                     types.BoolType,
+	"allowas_in":                   types.Int64Type,
+	"maximum_prefix":               types.Int64Type,
+	"maximum_prefix_restart":       types.Int64Type,
+	"maximum_prefix_warning_only":  types.BoolType,
+	"soft_reconfiguration_inbound": types.BoolType,
+	"send_community":               types.StringType,
+}
+
 func quaggaBGPNeighborResourceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Configure neighbors for BGP.",
@@ -70,8 +225,10 @@ func quaggaBGPNeighborResourceSchema() schema.Schema {
 				Required:            true,
 			},
 			"remote_as": schema.Int64Attribute{
-				MarkdownDescription: "The neighbor AS.",
-				Required:            true,
+				MarkdownDescription: "The neighbor AS. Set to `-1` to inherit it from `peer_group`. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
 			},
 			"md5_password": schema.StringAttribute{
 				MarkdownDescription: "The password for BGP authentication. Defaults to `\"\"`.",
@@ -134,7 +291,7 @@ func quaggaBGPNeighborResourceSchema() schema.Schema {
 				Default:             booldefault.StaticBool(false),
 			},
 			"bfd": schema.BoolAttribute{
-				MarkdownDescription: "Enable BFD support for this neighbor. Defaults to `false`.",
+				MarkdownDescription: "Enable BFD support for this neighbor. Timers can be tuned with `opnsense_quagga_bfd_peer`. Defaults to `false`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -208,6 +365,20 @@ func quaggaBGPNeighborResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"peer_group": schema.StringAttribute{
+				MarkdownDescription: "The peer group ID this neighbor belongs to, e.g. `opnsense_quagga_bgp_peergroup.example.id`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"shutdown": schema.BoolAttribute{
+				MarkdownDescription: "Administratively shut down the session with this neighbor, keeping its configuration. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ipv4_unicast": bgpNeighborAddressFamilyResourceAttribute("IPv4"),
+			"ipv6_unicast": bgpNeighborAddressFamilyResourceAttribute("IPv6"),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the neighbor.",
@@ -241,7 +412,7 @@ func bgpNeighborDataSourceSchema() dschema.Schema {
 				Computed:            true,
 			},
 			"remote_as": dschema.Int64Attribute{
-				MarkdownDescription: "The neighbor AS.",
+				MarkdownDescription: "The neighbor AS, or `-1` if inherited from the peer group.",
 				Computed:            true,
 			},
 			"md5_password": dschema.StringAttribute{
@@ -332,16 +503,65 @@ func bgpNeighborDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "The route map ID for outbound direction.",
 				Computed:            true,
 			},
+			"peer_group": dschema.StringAttribute{
+				MarkdownDescription: "The peer group ID this neighbor belongs to.",
+				Computed:            true,
+			},
+			"shutdown": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the session with this neighbor is administratively shut down.",
+				Computed:            true,
+			},
+			"ipv4_unicast": bgpNeighborAddressFamilyDataSourceAttribute("IPv4"),
+			"ipv6_unicast": bgpNeighborAddressFamilyDataSourceAttribute("IPv6"),
 		},
 	}
 }
 
+// bgpNeighborDefaultActivate returns whether an address family is activated by default
+// for a new neighbor, which is the case when the family matches the peer address.
+func bgpNeighborDefaultActivate(peerIP string, family string) bool {
+	ip := net.ParseIP(peerIP)
+	isIPv6 := ip != nil && ip.To4() == nil
+	if family == "ipv6_unicast" {
+		return isIPv6
+	}
+	return !isIPv6
+}
+
+// bgpNeighborSameAddressFamily returns whether two peer addresses belong to the same
+// address family, so the activate values of one still apply to the other.
+func bgpNeighborSameAddressFamily(a string, b string) bool {
+	return bgpNeighborDefaultActivate(a, "ipv6_unicast") == bgpNeighborDefaultActivate(b, "ipv6_unicast")
+}
+
+// bgpNeighborHasRemoteAS returns whether the remote AS is set, either on the neighbor
+// itself or through its peer group.
+func bgpNeighborHasRemoteAS(d *bgpNeighborResourceModel) bool {
+	hasRemoteAS := !d.RemoteAS.IsNull() && d.RemoteAS.ValueInt64() != -1
+	hasPeerGroup := !d.PeerGroup.IsNull() && d.PeerGroup.ValueString() != ""
+	return hasRemoteAS || hasPeerGroup
+}
+
+// resolveBGPNeighborActivate fills in activate values that could not be planned,
+// because the peer address was not known yet.
+func resolveBGPNeighborActivate(d *bgpNeighborResourceModel) {
+	families := map[string]*bgpNeighborAddressFamily{
+		"ipv4_unicast": d.IPv4Unicast,
+		"ipv6_unicast": d.IPv6Unicast,
+	}
+	for family, settings := range families {
+		if settings != nil && (settings.Activate.IsNull() || settings.Activate.IsUnknown()) {
+			settings.Activate = types.BoolValue(bgpNeighborDefaultActivate(d.PeerIP.ValueString(), family))
+		}
+	}
+}
+
 func convertBGPNeighborSchemaToStruct(d *bgpNeighborResourceModel) (*quagga.BGPNeighbor, error) {
 	return &quagga.BGPNeighbor{
 		Enabled:               tools.BoolToString(d.Enabled.ValueBool()),
 		Description:           d.Description.ValueString(),
 		PeerIP:                d.PeerIP.ValueString(),
-		RemoteAS:              tools.Int64ToString(d.RemoteAS.ValueInt64()),
+		RemoteAS:              tools.Int64ToStringNegative(d.RemoteAS.ValueInt64()),
 		Password:              d.Password.ValueString(),
 		Weight:                tools.Int64ToStringNegative(d.Weight.ValueInt64()),
 		LocalIP:               d.LocalIP.ValueString(),
@@ -364,6 +584,24 @@ func convertBGPNeighborSchemaToStruct(d *bgpNeighborResourceModel) (*quagga.BGPN
 		PrefixListOut:         api.SelectedMap(d.PrefixListOut.ValueString()),
 		RouteMapIn:            api.SelectedMap(d.RouteMapIn.ValueString()),
 		RouteMapOut:           api.SelectedMap(d.RouteMapOut.ValueString()),
+		PeerGroup:             api.SelectedMap(d.PeerGroup.ValueString()),
+		Shutdown:              tools.BoolToString(d.Shutdown.ValueBool()),
+
+		IPv4Activate:                   tools.BoolToString(d.IPv4Unicast.Activate.ValueBool()),
+		IPv4AllowASIn:                  tools.Int64ToStringNegative(d.IPv4Unicast.AllowASIn.ValueInt64()),
+		IPv4MaximumPrefix:              tools.Int64ToStringNegative(d.IPv4Unicast.MaximumPrefix.ValueInt64()),
+		IPv4MaximumPrefixRestart:       tools.Int64ToStringNegative(d.IPv4Unicast.MaximumPrefixRestart.ValueInt64()),
+		IPv4MaximumPrefixWarningOnly:   tools.BoolToString(d.IPv4Unicast.MaximumPrefixWarningOnly.ValueBool()),
+		IPv4SoftReconfigurationInbound: tools.BoolToString(d.IPv4Unicast.SoftReconfigurationInbound.ValueBool()),
+		IPv4SendCommunity:              api.SelectedMap(d.IPv4Unicast.SendCommunity.ValueString()),
+
+		IPv6Activate:                   tools.BoolToString(d.IPv6Unicast.Activate.ValueBool()),
+		IPv6AllowASIn:                  tools.Int64ToStringNegative(d.IPv6Unicast.AllowASIn.ValueInt64()),
+		IPv6MaximumPrefix:              tools.Int64ToStringNegative(d.IPv6Unicast.MaximumPrefix.ValueInt64()),
+		IPv6MaximumPrefixRestart:       tools.Int64ToStringNegative(d.IPv6Unicast.MaximumPrefixRestart.ValueInt64()),
+		IPv6MaximumPrefixWarningOnly:   tools.BoolToString(d.IPv6Unicast.MaximumPrefixWarningOnly.ValueBool()),
+		IPv6SoftReconfigurationInbound: tools.BoolToString(d.IPv6Unicast.SoftReconfigurationInbound.ValueBool()),
+		IPv6SendCommunity:              api.SelectedMap(d.IPv6Unicast.SendCommunity.ValueString()),
 	}, nil
 }
 
@@ -395,5 +633,25 @@ func convertBGPNeighborStructToSchema(d *quagga.BGPNeighbor) (*bgpNeighborResour
 		PrefixListOut:         types.StringValue(d.PrefixListOut.String()),
 		RouteMapIn:            types.StringValue(d.RouteMapIn.String()),
 		RouteMapOut:           types.StringValue(d.RouteMapOut.String()),
+		PeerGroup:             types.StringValue(d.PeerGroup.String()),
+		Shutdown:              types.BoolValue(tools.StringToBool(d.Shutdown)),
+		IPv4Unicast: &bgpNeighborAddressFamily{
+			Activate:                   types.BoolValue(tools.StringToBool(d.IPv4Activate)),
+			AllowASIn:                  types.Int64Value(tools.StringToInt64(d.IPv4AllowASIn)),
+			MaximumPrefix:              types.Int64Value(tools.StringToInt64(d.IPv4MaximumPrefix)),
+			MaximumPrefixRestart:       types.Int64Value(tools.StringToInt64(d.IPv4MaximumPrefixRestart)),
+			MaximumPrefixWarningOnly:   types.BoolValue(tools.StringToBool(d.IPv4MaximumPrefixWarningOnly)),
+			SoftReconfigurationInbound: types.BoolValue(tools.StringToBool(d.IPv4SoftReconfigurationInbound)),
+			SendCommunity:              types.StringValue(d.IPv4SendCommunity.String()),
+		},
+		IPv6Unicast: &bgpNeighborAddressFamily{
+			Activate:                   types.BoolValue(tools.StringToBool(d.IPv6Activate)),
+			AllowASIn:                  types.Int64Value(tools.StringToInt64(d.IPv6AllowASIn)),
+			MaximumPrefix:              types.Int64Value(tools.StringToInt64(d.IPv6MaximumPrefix)),
+			MaximumPrefixRestart:       types.Int64Value(tools.StringToInt64(d.IPv6MaximumPrefixRestart)),
+			MaximumPrefixWarningOnly:   types.BoolValue(tools.StringToBool(d.IPv6MaximumPrefixWarningOnly)),
+			SoftReconfigurationInbound: types.BoolValue(tools.StringToBool(d.IPv6SoftReconfigurationInbound)),
+			SendCommunity:              types.StringValue(d.IPv6SendCommunity.String()),
+		},
 	}, nil
 }
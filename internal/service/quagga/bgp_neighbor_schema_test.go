@@ -0,0 +1,89 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBGPNeighborDefaultActivate(t *testing.T) {
+	tests := []struct {
+		peerIP string
+		ipv4   bool
+		ipv6   bool
+	}{
+		{peerIP: "192.0.2.1", ipv4: true, ipv6: false},
+		{peerIP: "2001:db8::10", ipv4: false, ipv6: true},
+		{peerIP: "::ffff:192.0.2.1", ipv4: true, ipv6: false},
+		{peerIP: "", ipv4: true, ipv6: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.peerIP, func(t *testing.T) {
+			assert.Equal(t, tt.ipv4, bgpNeighborDefaultActivate(tt.peerIP, "ipv4_unicast"))
+			assert.Equal(t, tt.ipv6, bgpNeighborDefaultActivate(tt.peerIP, "ipv6_unicast"))
+		})
+	}
+}
+
+func TestResolveBGPNeighborActivate(t *testing.T) {
+	model := &bgpNeighborResourceModel{
+		PeerIP:      types.StringValue("2001:db8::10"),
+		IPv4Unicast: &bgpNeighborAddressFamily{Activate: types.BoolUnknown()},
+		IPv6Unicast: &bgpNeighborAddressFamily{Activate: types.BoolNull()},
+	}
+
+	resolveBGPNeighborActivate(model)
+
+	assert.Equal(t, types.BoolValue(false), model.IPv4Unicast.Activate)
+	assert.Equal(t, types.BoolValue(true), model.IPv6Unicast.Activate)
+
+	// Explicit values are kept
+	model.IPv4Unicast.Activate = types.BoolValue(true)
+	resolveBGPNeighborActivate(model)
+	assert.Equal(t, types.BoolValue(true), model.IPv4Unicast.Activate)
+}
+
+func TestBGPNeighborSameAddressFamily(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     string
+		b     string
+		equal bool
+	}{
+		{name: "ipv4_to_ipv4", a: "192.0.2.1", b: "192.0.2.2", equal: true},
+		{name: "ipv6_to_ipv6", a: "2001:db8::10", b: "2001:db8::20", equal: true},
+		{name: "ipv4_to_ipv6", a: "192.0.2.1", b: "2001:db8::10", equal: false},
+		{name: "ipv6_to_ipv4", a: "2001:db8::10", b: "192.0.2.1", equal: false},
+		{name: "mapped_ipv4", a: "::ffff:192.0.2.1", b: "192.0.2.1", equal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, bgpNeighborSameAddressFamily(tt.a, tt.b))
+		})
+	}
+}
+
+func TestBGPNeighborHasRemoteAS(t *testing.T) {
+	tests := []struct {
+		name      string
+		remoteAS  types.Int64
+		peerGroup types.String
+		expected  bool
+	}{
+		{name: "remote_as", remoteAS: types.Int64Value(65001), peerGroup: types.StringValue(""), expected: true},
+		{name: "peer_group", remoteAS: types.Int64Value(-1), peerGroup: types.StringValue("group-uuid"), expected: true},
+		{name: "both", remoteAS: types.Int64Value(65001), peerGroup: types.StringValue("group-uuid"), expected: true},
+		{name: "neither", remoteAS: types.Int64Value(-1), peerGroup: types.StringValue(""), expected: false},
+		{name: "null", remoteAS: types.Int64Null(), peerGroup: types.StringNull(), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &bgpNeighborResourceModel{RemoteAS: tt.remoteAS, PeerGroup: tt.peerGroup}
+			assert.Equal(t, tt.expected, bgpNeighborHasRemoteAS(model))
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bgpPeerGroupResourceModel describes the resource data model.
+type bgpPeerGroupResourceModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Description   types.String `tfsdk:"description"`
+	Name          types.String `tfsdk:"name"`
+	RemoteAS      types.Int64  `tfsdk:"remote_as"`
+	UpdateSource  types.String `tfsdk:"update_source"`
+	NextHopSelf   types.Bool   `tfsdk:"next_hop_self"`
+	DefaultRoute  types.Bool   `tfsdk:"default_route"`
+	PrefixListIn  types.String `tfsdk:"prefix_list_in"`
+	PrefixListOut types.String `tfsdk:"prefix_list_out"`
+	RouteMapIn    types.String `tfsdk:"route_map_in"`
+	RouteMapOut   types.String `tfsdk:"route_map_out"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func bgpPeerGroupResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure peer groups for BGP. Neighbors referencing a peer group through `peer_group` inherit its settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this peer group. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this peer group. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of this peer group.",
+				Required:            true,
+			},
+			"remote_as": schema.Int64Attribute{
+				MarkdownDescription: "The AS of all neighbors in this peer group. Set to `-1` to configure it on each neighbor instead. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 4294967295),
+					),
+				},
+			},
+			"update_source": schema.StringAttribute{
+				MarkdownDescription: "Physical name of the IPv4 interface facing the peers. Must be a valid OPNsense interface in lowercase (e.g. `wan`). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"next_hop_self": schema.BoolAttribute{
+				MarkdownDescription: "Enable the next-hop-self command. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_route": schema.BoolAttribute{
+				MarkdownDescription: "Enable to send Defaultroute. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"prefix_list_in": schema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for inbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prefix_list_out": schema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for outbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"route_map_in": schema.StringAttribute{
+				MarkdownDescription: "The route map ID for inbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"route_map_out": schema.StringAttribute{
+				MarkdownDescription: "The route map ID for outbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the peer group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func bgpPeerGroupDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure peer groups for BGP.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Enable this peer group.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this peer group.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "The name of this peer group.",
+				Computed:            true,
+			},
+			"remote_as": dschema.Int64Attribute{
+				MarkdownDescription: "The AS of all neighbors in this peer group, or `-1` if unset.",
+				Computed:            true,
+			},
+			"update_source": dschema.StringAttribute{
+				MarkdownDescription: "Physical name of the IPv4 interface facing the peers.",
+				Computed:            true,
+			},
+			"next_hop_self": dschema.BoolAttribute{
+				MarkdownDescription: "Enable the next-hop-self command.",
+				Computed:            true,
+			},
+			"default_route": dschema.BoolAttribute{
+				MarkdownDescription: "Enable to send Defaultroute.",
+				Computed:            true,
+			},
+			"prefix_list_in": dschema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for inbound direction.",
+				Computed:            true,
+			},
+			"prefix_list_out": dschema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for outbound direction.",
+				Computed:            true,
+			},
+			"route_map_in": dschema.StringAttribute{
+				MarkdownDescription: "The route map ID for inbound direction.",
+				Computed:            true,
+			},
+			"route_map_out": dschema.StringAttribute{
+				MarkdownDescription: "The route map ID for outbound direction.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertBGPPeerGroupSchemaToStruct(d *bgpPeerGroupResourceModel) (*quagga.BGPPeerGroup, error) {
+	return &quagga.BGPPeerGroup{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Description:   d.Description.ValueString(),
+		Name:          d.Name.ValueString(),
+		RemoteAS:      tools.Int64ToStringNegative(d.RemoteAS.ValueInt64()),
+		UpdateSource:  api.SelectedMap(d.UpdateSource.ValueString()),
+		NextHopSelf:   tools.BoolToString(d.NextHopSelf.ValueBool()),
+		DefaultRoute:  tools.BoolToString(d.DefaultRoute.ValueBool()),
+		PrefixListIn:  api.SelectedMap(d.PrefixListIn.ValueString()),
+		PrefixListOut: api.SelectedMap(d.PrefixListOut.ValueString()),
+		RouteMapIn:    api.SelectedMap(d.RouteMapIn.ValueString()),
+		RouteMapOut:   api.SelectedMap(d.RouteMapOut.ValueString()),
+	}, nil
+}
+
+func convertBGPPeerGroupStructToSchema(d *quagga.BGPPeerGroup) (*bgpPeerGroupResourceModel, error) {
+	return &bgpPeerGroupResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:   types.StringValue(d.Description),
+		Name:          types.StringValue(d.Name),
+		RemoteAS:      types.Int64Value(tools.StringToInt64(d.RemoteAS)),
+		UpdateSource:  types.StringValue(d.UpdateSource.String()),
+		NextHopSelf:   types.BoolValue(tools.StringToBool(d.NextHopSelf)),
+		DefaultRoute:  types.BoolValue(tools.StringToBool(d.DefaultRoute)),
+		PrefixListIn:  types.StringValue(d.PrefixListIn.String()),
+		PrefixListOut: types.StringValue(d.PrefixListOut.String()),
+		RouteMapIn:    types.StringValue(d.RouteMapIn.String()),
+		RouteMapOut:   types.StringValue(d.RouteMapOut.String()),
+	}, nil
+}
@@ -0,0 +1,465 @@
+package quagga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &bgpPrefixListSetResource{}
+var _ resource.ResourceWithConfigure = &bgpPrefixListSetResource{}
+var _ resource.ResourceWithValidateConfig = &bgpPrefixListSetResource{}
+var _ resource.ResourceWithModifyPlan = &bgpPrefixListSetResource{}
+var _ resource.ResourceWithImportState = &bgpPrefixListSetResource{}
+
+func newBGPPrefixListSetResource() resource.Resource {
+	return &bgpPrefixListSetResource{}
+}
+
+// bgpPrefixListSetResource defines the resource implementation.
+type bgpPrefixListSetResource struct {
+	client opnsense.Client
+}
+
+func (r *bgpPrefixListSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bgp_prefixlist_set"
+}
+
+func (r *bgpPrefixListSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = bgpPrefixListSetResourceSchema()
+}
+
+func (r *bgpPrefixListSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *bgpPrefixListSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *bgpPrefixListSetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Entries.IsNull() || data.Entries.IsUnknown() {
+		return
+	}
+
+	entries, err := bgpPrefixListSetEntriesFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	// ip_version defaults to IPv4 when not configured
+	ipVersion := "IPv4"
+	if data.IPVersion.IsUnknown() {
+		ipVersion = ""
+	} else if !data.IPVersion.IsNull() {
+		ipVersion = data.IPVersion.ValueString()
+	}
+
+	for _, problem := range validateBGPPrefixListSetEntries(ipVersion, entries) {
+		resp.Diagnostics.AddError("Invalid Prefix List Entry", problem)
+	}
+}
+
+func (r *bgpPrefixListSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *bgpPrefixListSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The prefix list is identified by its name
+	if !plan.Name.IsUnknown() {
+		plan.Id = plan.Name
+	}
+
+	// Entries that keep their sequence number are updated in place, so they keep their UUID
+	if !req.State.Raw.IsNull() && !plan.Entries.IsNull() && !plan.Entries.IsUnknown() {
+		var state *bgpPrefixListSetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		planned, err := bgpPrefixListSetEntriesFromModel(ctx, plan)
+		if err != nil {
+			// Entries that are not known yet are planned again once they are
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+			return
+		}
+
+		prior, err := bgpPrefixListSetEntriesFromModel(ctx, state)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to plan bgp prefix list set, got error: %s", err))
+			return
+		}
+
+		plan.Entries, err = bgpPrefixListSetEntriesToList(ctx, keepBGPPrefixListSetEntryIds(planned, prior))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to plan bgp prefix list set, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// reconcile adds, updates and removes prefix list entries so that OPNsense matches
+// the plan, and reconfigures FRR once if anything changed. Entries are matched against
+// the prior state by sequence number, and entries that did not change are left
+// untouched. The returned list contains every entry known to exist in OPNsense, even
+// when an error occurs part way through.
+func (r *bgpPrefixListSetResource) reconcile(ctx context.Context, plan *bgpPrefixListSetResourceModel, prior *bgpPrefixListSetResourceModel) (types.List, error) {
+	planned, err := bgpPrefixListSetEntriesFromModel(ctx, plan)
+	if err != nil {
+		return types.ListNull(types.ObjectType{AttrTypes: bgpPrefixListSetEntryAttrTypes}), err
+	}
+
+	var existing []bgpPrefixListSetEntry
+	if prior != nil {
+		existing, err = bgpPrefixListSetEntriesFromModel(ctx, prior)
+		if err != nil {
+			return types.ListNull(types.ObjectType{AttrTypes: bgpPrefixListSetEntryAttrTypes}), err
+		}
+	}
+
+	// Track which entries exist in OPNsense, keyed by sequence number
+	applied := map[int64]bgpPrefixListSetEntry{}
+	for _, e := range existing {
+		applied[e.Sequence.ValueInt64()] = e
+	}
+
+	changed, err := r.reconcileEntries(ctx, plan, prior, planned, applied)
+
+	if changed {
+		reconfigureErr := r.reconfigure(ctx)
+		if err == nil {
+			err = reconfigureErr
+		}
+	}
+
+	// Build the resulting entries in plan order, followed by any entries that could not be removed
+	var result []bgpPrefixListSetEntry
+	for _, e := range planned {
+		if a, ok := applied[e.Sequence.ValueInt64()]; ok {
+			result = append(result, a)
+			delete(applied, e.Sequence.ValueInt64())
+		}
+	}
+	for _, e := range existing {
+		if a, ok := applied[e.Sequence.ValueInt64()]; ok {
+			result = append(result, a)
+		}
+	}
+
+	list, convErr := bgpPrefixListSetEntriesToList(ctx, result)
+	if convErr != nil {
+		return list, convErr
+	}
+
+	return list, err
+}
+
+// reconcileEntries makes the prefix list calls, without reconfiguring FRR. It reports
+// whether any call succeeded.
+func (r *bgpPrefixListSetResource) reconcileEntries(ctx context.Context, plan *bgpPrefixListSetResourceModel, prior *bgpPrefixListSetResourceModel, planned []bgpPrefixListSetEntry, applied map[int64]bgpPrefixListSetEntry) (bool, error) {
+	changed := false
+
+	wanted := map[int64]bool{}
+	for _, e := range planned {
+		wanted[e.Sequence.ValueInt64()] = true
+	}
+
+	// Remove entries first, so their sequence numbers are free for new entries
+	for seq, e := range applied {
+		if wanted[seq] {
+			continue
+		}
+
+		if err := r.deleteEntry(ctx, e); err != nil {
+			return changed, fmt.Errorf("unable to delete prefix list entry %d: %w", seq, err)
+		}
+
+		changed = true
+		delete(applied, seq)
+		tflog.Debug(ctx, "removed prefix list entry", map[string]any{"sequence": seq})
+	}
+
+	for _, e := range planned {
+		seq := e.Sequence.ValueInt64()
+		entry := convertBGPPrefixListSetEntryToStruct(plan, &e)
+
+		if current, ok := applied[seq]; ok {
+			// Leave unchanged entries alone
+			if reflect.DeepEqual(convertBGPPrefixListSetEntryToStruct(prior, &current), entry) {
+				continue
+			}
+
+			res, err := r.client.Quagga().QuaggaSetBGPPrefixList(ctx, current.Id.ValueString(), *entry)
+			if err != nil {
+				return changed, fmt.Errorf("unable to update prefix list entry %d: %w", seq, err)
+			}
+			if res != nil && res.Result == "failed" {
+				return changed, errors.New(formatActionResultFailure(fmt.Sprintf("update prefix list entry %d", seq), res))
+			}
+
+			changed = true
+			e.Id = current.Id
+			applied[seq] = e
+			tflog.Debug(ctx, "updated prefix list entry", map[string]any{"sequence": seq})
+			continue
+		}
+
+		res, err := r.client.Quagga().QuaggaAddBGPPrefixList(ctx, *entry)
+		if err != nil {
+			return changed, fmt.Errorf("unable to add prefix list entry %d: %w", seq, err)
+		}
+		if res == nil || res.Result == "failed" || res.UUID == "" {
+			return changed, errors.New(formatActionResultFailure(fmt.Sprintf("add prefix list entry %d", seq), res))
+		}
+
+		changed = true
+		e.Id = types.StringValue(res.UUID)
+		applied[seq] = e
+		tflog.Debug(ctx, "added prefix list entry", map[string]any{"sequence": seq})
+	}
+
+	return changed, nil
+}
+
+// deleteEntry removes a single prefix list entry, without reconfiguring FRR. Entries
+// that are already gone are not an error.
+func (r *bgpPrefixListSetResource) deleteEntry(ctx context.Context, e bgpPrefixListSetEntry) error {
+	res, err := r.client.Quagga().QuaggaDelBGPPrefixList(ctx, e.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+		return err
+	}
+	if res != nil && res.Result == "failed" {
+		return errors.New(formatActionResultFailure("delete prefix list entry", res))
+	}
+	return nil
+}
+
+func (r *bgpPrefixListSetResource) reconfigure(ctx context.Context) error {
+	res, err := r.client.Quagga().QuaggaReconfigure(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to reconfigure quagga: %w", err)
+	}
+	if res != nil && res.Result == "failed" {
+		return errors.New(formatActionResultFailure("reconfigure quagga", res))
+	}
+	return nil
+}
+
+func (r *bgpPrefixListSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *bgpPrefixListSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add all entries to quagga
+	entries, err := r.reconcile(ctx, data, nil)
+	data.Entries = entries
+	data.Id = data.Name
+
+	// Save the entries that were created, so they are cleaned up on destroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create bgp prefix list set, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+}
+
+func (r *bgpPrefixListSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *bgpPrefixListSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []bgpPrefixListSetEntry
+	if data.Entries.IsNull() {
+		// Imported prefix lists only know their name, so look up their entries
+		rows, err := r.client.Quagga().SearchBGPPrefixLists(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read bgp prefix list set, got error: %s", err))
+			return
+		}
+		existing = bgpPrefixListSetEntriesByName(rows, data.Id.ValueString())
+	} else {
+		var err error
+		existing, err = bgpPrefixListSetEntriesFromModel(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read bgp prefix list set, got error: %s", err))
+			return
+		}
+	}
+
+	// Get each entry from OPNsense quagga API
+	var entries []bgpPrefixListSetEntry
+	for _, e := range existing {
+		prefixList, err := r.client.Quagga().GetBGPPrefixList(ctx, e.Id.ValueString())
+		if err != nil {
+			var notFoundError *errs.NotFoundError
+			if errors.As(err, &notFoundError) {
+				tflog.Warn(ctx, fmt.Sprintf("bgp prefix list entry %d not present in remote, removing from state", e.Sequence.ValueInt64()))
+				continue
+			}
+
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read bgp prefix list set, got error: %s", err))
+			return
+		}
+
+		// The shared settings are the same on every entry, so take them from the first one
+		if len(entries) == 0 {
+			data.Enabled = types.BoolValue(tools.StringToBool(prefixList.Enabled))
+			data.Description = types.StringValue(prefixList.Description)
+			data.Name = types.StringValue(prefixList.Name)
+			data.IPVersion = types.StringValue(prefixList.IPVersion.String())
+		}
+
+		entries = append(entries, convertBGPPrefixListSetEntryStructToSchema(e.Id.ValueString(), prefixList))
+	}
+
+	if len(entries) == 0 {
+		tflog.Warn(ctx, "bgp prefix list set not present in remote, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	list, err := bgpPrefixListSetEntriesToList(ctx, entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp prefix list set, got error: %s", err))
+		return
+	}
+	data.Entries = list
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bgpPrefixListSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *bgpPrefixListSetResourceModel
+	var state *bgpPrefixListSetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply only the entries that differ from the prior state
+	entries, err := r.reconcile(ctx, data, state)
+	data.Entries = entries
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update bgp prefix list set, got error: %s", err))
+		return
+	}
+}
+
+func (r *bgpPrefixListSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *bgpPrefixListSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := bgpPrefixListSetEntriesFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete bgp prefix list set, got error: %s", err))
+		return
+	}
+
+	// Remove all entries, then reconfigure FRR once for the entries that were removed
+	removed := 0
+	var deleteErr error
+	for _, e := range entries {
+		if deleteErr = r.deleteEntry(ctx, e); deleteErr != nil {
+			break
+		}
+		removed++
+	}
+
+	if removed > 0 {
+		if err := r.reconfigure(ctx); err != nil && deleteErr == nil {
+			deleteErr = err
+		}
+	}
+
+	if deleteErr != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete bgp prefix list set, got error: %s", deleteErr))
+		return
+	}
+}
+
+func (r *bgpPrefixListSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
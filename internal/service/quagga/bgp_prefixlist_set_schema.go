@@ -0,0 +1,307 @@
+package quagga
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bgpPrefixListSetResourceModel describes the resource data model.
+type bgpPrefixListSetResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	IPVersion   types.String `tfsdk:"ip_version"`
+	Entries     types.List   `tfsdk:"entries"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// bgpPrefixListSetEntry describes a single entry of a prefix list set.
+type bgpPrefixListSetEntry struct {
+	Sequence types.Int64  `tfsdk:"sequence"`
+	Action   types.String `tfsdk:"action"`
+	Network  types.String `tfsdk:"network"`
+	GE       types.Int64  `tfsdk:"ge"`
+	LE       types.Int64  `tfsdk:"le"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var bgpPrefixListSetEntryAttrTypes = map[string]attr.Type{
+	"sequence": types.Int64Type,
+	"action":   types.StringType,
+	"network":  types.StringType,
+	"ge":       types.Int64Type,
+	"le":       types.Int64Type,
+	"id":       types.StringType,
+}
+
+func bgpPrefixListSetResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure a complete prefix list for BGP. Each entry is stored as a prefix list in OPNsense, and only entries that differ are added, changed or removed on apply. FRR is reconfigured once per apply, and only when an entry changed. Do not combine with `opnsense_quagga_bgp_prefixlist` resources using the same name.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable all entries of this prefix list. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description, set on all entries of this prefix list. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of this prefix list.",
+				Required:            true,
+			},
+			"ip_version": schema.StringAttribute{
+				MarkdownDescription: "Set the IP version to use. All entries must use networks of this version. Defaults to `\"IPv4\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("IPv4"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("IPv4", "IPv6"),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The entries of this prefix list. Entries are evaluated in order of their sequence number.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sequence": schema.Int64Attribute{
+							MarkdownDescription: "The sequence number of this entry (1-4294967294). Must be unique within the prefix list.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4294967294),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Set permit for match or deny to negate the entry. Defaults to `\"permit\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("permit"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("permit", "deny"),
+							},
+						},
+						"network": schema.StringAttribute{
+							MarkdownDescription: "The network to match, in CIDR notation (e.g. `10.0.0.0/8`).",
+							Required:            true,
+							Validators: []validator.String{
+								validators.CIDR(),
+							},
+						},
+						"ge": schema.Int64Attribute{
+							MarkdownDescription: "Also match more specific prefixes with a length of at least this value. Set to `-1` to disable. Defaults to `-1`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(-1),
+							Validators: []validator.Int64{
+								int64validator.Any(
+									int64validator.OneOf(-1),
+									int64validator.Between(0, 128),
+								),
+							},
+						},
+						"le": schema.Int64Attribute{
+							MarkdownDescription: "Also match more specific prefixes with a length of at most this value. Set to `-1` to disable. Defaults to `-1`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(-1),
+							Validators: []validator.Int64{
+								int64validator.Any(
+									int64validator.OneOf(-1),
+									int64validator.Between(0, 128),
+								),
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the prefix list entry in OPNsense.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The name of this prefix list. Used to import it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// validateBGPPrefixListSetEntries returns a list of problems with the entries of the prefix list.
+func validateBGPPrefixListSetEntries(ipVersion string, entries []bgpPrefixListSetEntry) []string {
+	var problems []string
+	seen := map[int64]bool{}
+
+	for _, e := range entries {
+		if e.Sequence.IsUnknown() || e.Network.IsUnknown() || e.GE.IsUnknown() || e.LE.IsUnknown() {
+			continue
+		}
+
+		seq := e.Sequence.ValueInt64()
+		if seen[seq] {
+			problems = append(problems, fmt.Sprintf("sequence %d is used by more than one entry.", seq))
+		}
+		seen[seq] = true
+
+		ip, network, err := net.ParseCIDR(e.Network.ValueString())
+		if err != nil {
+			// Reported by the attribute validator
+			continue
+		}
+
+		isIPv4 := ip.To4() != nil
+		if ipVersion == "IPv4" && !isIPv4 || ipVersion == "IPv6" && isIPv4 {
+			problems = append(problems, fmt.Sprintf("entry %d: network %s is not an %s network.", seq, e.Network.ValueString(), ipVersion))
+		}
+
+		length, bits := network.Mask.Size()
+		ge, le := e.GE.ValueInt64(), e.LE.ValueInt64()
+		if ge != -1 && (ge <= int64(length) || ge > int64(bits)) {
+			problems = append(problems, fmt.Sprintf("entry %d: ge must be greater than the prefix length %d and at most %d.", seq, length, bits))
+		}
+		if le != -1 && (le < int64(length) || le > int64(bits)) {
+			problems = append(problems, fmt.Sprintf("entry %d: le must be between the prefix length %d and %d.", seq, length, bits))
+		}
+		if ge != -1 && le != -1 && le < ge {
+			problems = append(problems, fmt.Sprintf("entry %d: le must be greater than or equal to ge.", seq))
+		}
+	}
+
+	return problems
+}
+
+// keepBGPPrefixListSetEntryIds returns the planned entries, with the UUID of the prior entry with the same sequence
+// number. Such entries are updated in place, so their UUID does not change.
+func keepBGPPrefixListSetEntryIds(planned []bgpPrefixListSetEntry, prior []bgpPrefixListSetEntry) []bgpPrefixListSetEntry {
+	ids := map[int64]types.String{}
+	for _, e := range prior {
+		if !e.Sequence.IsUnknown() && !e.Sequence.IsNull() {
+			ids[e.Sequence.ValueInt64()] = e.Id
+		}
+	}
+
+	entries := make([]bgpPrefixListSetEntry, len(planned))
+	for i, e := range planned {
+		if id, ok := ids[e.Sequence.ValueInt64()]; ok && e.Id.IsUnknown() && !e.Sequence.IsUnknown() {
+			e.Id = id
+		}
+		entries[i] = e
+	}
+	return entries
+}
+
+// bgpPrefixListSetEntriesByName returns the entries of the prefix list with the given name, ordered by sequence
+// number. Only the sequence number and UUID of each entry are set.
+func bgpPrefixListSetEntriesByName(rows []quagga.BGPPrefixListRow, name string) []bgpPrefixListSetEntry {
+	var entries []bgpPrefixListSetEntry
+	for _, row := range rows {
+		if row.Name != name {
+			continue
+		}
+		entries = append(entries, bgpPrefixListSetEntry{
+			Sequence: types.Int64Value(tools.StringToInt64(row.SequenceNumber)),
+			Id:       types.StringValue(row.UUID),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Sequence.ValueInt64() < entries[j].Sequence.ValueInt64()
+	})
+	return entries
+}
+
+func convertBGPPrefixListSetEntryToStruct(d *bgpPrefixListSetResourceModel, e *bgpPrefixListSetEntry) *quagga.BGPPrefixList {
+	network := e.Network.ValueString()
+	if e.GE.ValueInt64() != -1 {
+		network += fmt.Sprintf(" ge %d", e.GE.ValueInt64())
+	}
+	if e.LE.ValueInt64() != -1 {
+		network += fmt.Sprintf(" le %d", e.LE.ValueInt64())
+	}
+
+	return &quagga.BGPPrefixList{
+		Enabled:        tools.BoolToString(d.Enabled.ValueBool()),
+		Description:    d.Description.ValueString(),
+		Name:           d.Name.ValueString(),
+		IPVersion:      api.SelectedMap(d.IPVersion.ValueString()),
+		SequenceNumber: tools.Int64ToString(e.Sequence.ValueInt64()),
+		Action:         api.SelectedMap(e.Action.ValueString()),
+		Network:        network,
+	}
+}
+
+func convertBGPPrefixListSetEntryStructToSchema(id string, d *quagga.BGPPrefixList) bgpPrefixListSetEntry {
+	entry := bgpPrefixListSetEntry{
+		Sequence: types.Int64Value(tools.StringToInt64(d.SequenceNumber)),
+		Action:   types.StringValue(d.Action.String()),
+		GE:       types.Int64Value(-1),
+		LE:       types.Int64Value(-1),
+		Id:       types.StringValue(id),
+	}
+
+	// The network is stored as e.g. "10.0.0.0/8 ge 16 le 24"
+	fields := strings.Fields(d.Network)
+	if len(fields) > 0 {
+		entry.Network = types.StringValue(fields[0])
+	} else {
+		entry.Network = types.StringValue("")
+	}
+	for i := 1; i+1 < len(fields); i += 2 {
+		length, err := strconv.ParseInt(fields[i+1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[i] {
+		case "ge":
+			entry.GE = types.Int64Value(length)
+		case "le":
+			entry.LE = types.Int64Value(length)
+		}
+	}
+
+	return entry
+}
+
+func bgpPrefixListSetEntriesFromModel(ctx context.Context, d *bgpPrefixListSetResourceModel) ([]bgpPrefixListSetEntry, error) {
+	var entries []bgpPrefixListSetEntry
+	if diags := d.Entries.ElementsAs(ctx, &entries, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to read prefix list entries: %v", diags)
+	}
+	return entries, nil
+}
+
+func bgpPrefixListSetEntriesToList(ctx context.Context, entries []bgpPrefixListSetEntry) (types.List, error) {
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: bgpPrefixListSetEntryAttrTypes}, entries)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: bgpPrefixListSetEntryAttrTypes}), fmt.Errorf("unable to convert prefix list entries: %v", diags)
+	}
+	return list, nil
+}
@@ -0,0 +1,278 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prefixListSetEntry(seq int64, network string, ge, le int64) bgpPrefixListSetEntry {
+	return bgpPrefixListSetEntry{
+		Sequence: types.Int64Value(seq),
+		Action:   types.StringValue("permit"),
+		Network:  types.StringValue(network),
+		GE:       types.Int64Value(ge),
+		LE:       types.Int64Value(le),
+		Id:       types.StringUnknown(),
+	}
+}
+
+func TestValidateBGPPrefixListSetEntries(t *testing.T) {
+	tests := []struct {
+		name      string
+		ipVersion string
+		entries   []bgpPrefixListSetEntry
+		problems  int
+	}{
+		{
+			name:      "valid_ipv4",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/8", 16, 24),
+				prefixListSetEntry(20, "192.168.0.0/16", -1, -1),
+			},
+		},
+		{
+			name:      "valid_ipv6",
+			ipVersion: "IPv6",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "2001:db8::/32", -1, 48),
+			},
+		},
+		{
+			name:      "duplicate_sequence",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/8", -1, -1),
+				prefixListSetEntry(10, "172.16.0.0/12", -1, -1),
+			},
+			problems: 1,
+		},
+		{
+			name:      "wrong_ip_version",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "2001:db8::/32", -1, -1),
+			},
+			problems: 1,
+		},
+		{
+			name:      "ge_not_longer_than_prefix",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/16", 16, -1),
+			},
+			problems: 1,
+		},
+		{
+			name:      "le_out_of_range",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/16", -1, 33),
+			},
+			problems: 1,
+		},
+		{
+			name:      "le_below_ge",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/8", 24, 20),
+			},
+			problems: 1,
+		},
+		{
+			name:      "unknown_ip_version_skips_family_check",
+			ipVersion: "",
+			entries: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "2001:db8::/32", -1, -1),
+			},
+		},
+		{
+			name:      "unknown_values_are_skipped",
+			ipVersion: "IPv4",
+			entries: []bgpPrefixListSetEntry{
+				{
+					Sequence: types.Int64Value(10),
+					Network:  types.StringUnknown(),
+					GE:       types.Int64Value(-1),
+					LE:       types.Int64Value(-1),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, validateBGPPrefixListSetEntries(tt.ipVersion, tt.entries), tt.problems)
+		})
+	}
+}
+
+func TestConvertBGPPrefixListSetEntryStructToSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		network string
+		want    bgpPrefixListSetEntry
+	}{
+		{
+			name:    "network_only",
+			network: "10.0.0.0/8",
+			want:    prefixListSetEntry(10, "10.0.0.0/8", -1, -1),
+		},
+		{
+			name:    "ge_and_le",
+			network: "10.0.0.0/8 ge 16 le 24",
+			want:    prefixListSetEntry(10, "10.0.0.0/8", 16, 24),
+		},
+		{
+			name:    "le_only",
+			network: "2001:db8::/32 le 48",
+			want:    prefixListSetEntry(10, "2001:db8::/32", -1, 48),
+		},
+		{
+			name:    "le_before_ge",
+			network: "10.0.0.0/8 le 24 ge 16",
+			want:    prefixListSetEntry(10, "10.0.0.0/8", 16, 24),
+		},
+		{
+			name:    "invalid_length_is_ignored",
+			network: "10.0.0.0/8 ge x",
+			want:    prefixListSetEntry(10, "10.0.0.0/8", -1, -1),
+		},
+		{
+			name:    "empty",
+			network: "",
+			want:    prefixListSetEntry(10, "", -1, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Id = types.StringValue("uuid")
+
+			got := convertBGPPrefixListSetEntryStructToSchema("uuid", &quagga.BGPPrefixList{
+				SequenceNumber: "10",
+				Action:         api.SelectedMap("permit"),
+				Network:        tt.network,
+			})
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConvertBGPPrefixListSetEntryRoundTrip(t *testing.T) {
+	model := &bgpPrefixListSetResourceModel{
+		Enabled:     types.BoolValue(true),
+		Description: types.StringValue(""),
+		Name:        types.StringValue("customers"),
+		IPVersion:   types.StringValue("IPv4"),
+	}
+	entry := prefixListSetEntry(10, "10.0.0.0/8", 16, 24)
+
+	prefixList := convertBGPPrefixListSetEntryToStruct(model, &entry)
+	assert.Equal(t, "10.0.0.0/8 ge 16 le 24", prefixList.Network)
+
+	entry.Id = types.StringValue("uuid")
+	assert.Equal(t, entry, convertBGPPrefixListSetEntryStructToSchema("uuid", prefixList))
+}
+
+func TestKeepBGPPrefixListSetEntryIds(t *testing.T) {
+	withId := func(e bgpPrefixListSetEntry, id string) bgpPrefixListSetEntry {
+		e.Id = types.StringValue(id)
+		return e
+	}
+
+	prior := []bgpPrefixListSetEntry{
+		withId(prefixListSetEntry(10, "10.0.0.0/8", -1, -1), "uuid-10"),
+		withId(prefixListSetEntry(20, "172.16.0.0/12", -1, -1), "uuid-20"),
+	}
+
+	tests := []struct {
+		name    string
+		planned []bgpPrefixListSetEntry
+		want    []string
+	}{
+		{
+			name: "unchanged",
+			planned: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/8", -1, -1),
+				prefixListSetEntry(20, "172.16.0.0/12", -1, -1),
+			},
+			want: []string{"uuid-10", "uuid-20"},
+		},
+		{
+			name: "changed_network_keeps_id",
+			planned: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "192.168.0.0/16", -1, -1),
+			},
+			want: []string{"uuid-10"},
+		},
+		{
+			name: "reordered",
+			planned: []bgpPrefixListSetEntry{
+				prefixListSetEntry(20, "172.16.0.0/12", -1, -1),
+				prefixListSetEntry(10, "10.0.0.0/8", -1, -1),
+			},
+			want: []string{"uuid-20", "uuid-10"},
+		},
+		{
+			name: "new_sequence_stays_unknown",
+			planned: []bgpPrefixListSetEntry{
+				prefixListSetEntry(10, "10.0.0.0/8", -1, -1),
+				prefixListSetEntry(30, "192.168.0.0/16", -1, -1),
+			},
+			want: []string{"uuid-10", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keepBGPPrefixListSetEntryIds(tt.planned, prior)
+
+			require.Len(t, got, len(tt.want))
+			for i, id := range tt.want {
+				if id == "" {
+					assert.True(t, got[i].Id.IsUnknown())
+					continue
+				}
+				assert.Equal(t, types.StringValue(id), got[i].Id)
+			}
+		})
+	}
+}
+
+func TestBGPPrefixListSetEntriesByName(t *testing.T) {
+	rows := []quagga.BGPPrefixListRow{
+		{UUID: "uuid-20", Name: "customers", SequenceNumber: "20"},
+		{UUID: "uuid-other", Name: "peers", SequenceNumber: "10"},
+		{UUID: "uuid-5", Name: "customers", SequenceNumber: "5"},
+		{UUID: "uuid-case", Name: "Customers", SequenceNumber: "1"},
+	}
+
+	tests := []struct {
+		name string
+		want []bgpPrefixListSetEntry
+	}{
+		{
+			name: "customers",
+			want: []bgpPrefixListSetEntry{
+				{Sequence: types.Int64Value(5), Id: types.StringValue("uuid-5")},
+				{Sequence: types.Int64Value(20), Id: types.StringValue("uuid-20")},
+			},
+		},
+		{
+			name: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, bgpPrefixListSetEntriesByName(rows, tt.name))
+		})
+	}
+}
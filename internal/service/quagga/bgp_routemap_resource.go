@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +18,7 @@ import (
 var _ resource.Resource = &bgpRouteMapResource{}
 var _ resource.ResourceWithConfigure = &bgpRouteMapResource{}
 var _ resource.ResourceWithImportState = &bgpRouteMapResource{}
+var _ resource.ResourceWithValidateConfig = &bgpRouteMapResource{}
 
 func newBGPRouteMapResource() resource.Resource {
 	return &bgpRouteMapResource{}
@@ -54,6 +55,20 @@ func (r *bgpRouteMapResource) Configure(ctx context.Context, req resource.Config
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *bgpRouteMapResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *bgpRouteMapResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if warning := validateBGPRouteMapSet(data.Set); warning != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("set"), "Unknown Set Command", warning)
+	}
+}
+
 func (r *bgpRouteMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *bgpRouteMapResourceModel
 
@@ -2,8 +2,11 @@ package quagga
 
 import (
 	"context"
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"fmt"
+	"regexp"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -18,6 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// routeMapSetRegex matches the FRR set commands accepted in the free-form `set` field. The command must be followed by
+// a space or the end of the value, so `metricx` or `tagged` are not matched.
+var routeMapSetRegex = regexp.MustCompile(`^(as-path (prepend|exclude)|aggregator as|atomic-aggregate|comm-list|community|extcommunity|ip next-hop|ipv6 next-hop|label-index|large-community|local-preference|metric|origin|originator-id|src|tag|weight)( |$)`)
+
 // bgpRouteMapResourceModel describes the resource data model.
 type bgpRouteMapResourceModel struct {
 	Enabled       types.Bool   `tfsdk:"enabled"`
@@ -92,7 +99,7 @@ func bgpRouteMapResourceSchema() schema.Schema {
 				ElementType:         types.StringType,
 			},
 			"set": schema.StringAttribute{
-				MarkdownDescription: "Free text field for your set, please be careful! You can set e.g. `local-preference 300` or `community 1:1` (http://www.nongnu.org/quagga/docs/docs-multi/Route-Map-Set-Command.html#Route-Map-Set-Command). Defaults to `\"\"`.",
+				MarkdownDescription: "Free text field for your set, please be careful! You can set e.g. `local-preference 300` or `community 1:1` (http://www.nongnu.org/quagga/docs/docs-multi/Route-Map-Set-Command.html#Route-Map-Set-Command). A warning is shown when the value does not start with a known FRR set command. Defaults to `\"\"`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
@@ -207,3 +214,18 @@ func convertBGPRouteMapStructToSchema(d *quagga.BGPRouteMap) (*bgpRouteMapResour
 
 	return model, nil
 }
+
+// validateBGPRouteMapSet returns a warning if the free-form set clause does not start with a known FRR set command.
+// FRR rejects unknown commands when the route map is applied, so this only warns, since new FRR releases may add
+// commands this list does not know about.
+func validateBGPRouteMapSet(set types.String) string {
+	if set.IsNull() || set.IsUnknown() || set.ValueString() == "" {
+		return ""
+	}
+
+	if !routeMapSetRegex.MatchString(set.ValueString()) {
+		return fmt.Sprintf("%q does not start with a known FRR set command (e.g. local-preference 200), so FRR may reject the route map when it is applied.", set.ValueString())
+	}
+
+	return ""
+}
@@ -0,0 +1,56 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteMapSetRegex(t *testing.T) {
+	tests := []struct {
+		value string
+		match bool
+	}{
+		{value: "local-preference 200", match: true},
+		{value: "as-path prepend 65000 65000", match: true},
+		{value: "atomic-aggregate", match: true},
+		{value: "metric +10", match: true},
+		{value: "ipv6 next-hop global 2001:db8::1", match: true},
+		{value: "metricx 10", match: false},
+		{value: "tagged 5", match: false},
+		{value: "weights 100", match: false},
+		{value: "as-path delete 65000", match: false},
+		{value: " metric 10", match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.match, routeMapSetRegex.MatchString(tt.value))
+		})
+	}
+}
+
+func TestValidateBGPRouteMapSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     types.String
+		warning string
+	}{
+		{name: "null", set: types.StringNull()},
+		{name: "unknown", set: types.StringUnknown()},
+		{name: "empty", set: types.StringValue("")},
+		{name: "known_command", set: types.StringValue("local-preference 200")},
+		{
+			name:    "unknown_command",
+			set:     types.StringValue("metricx 10"),
+			warning: "\"metricx 10\" does not start with a known FRR set command (e.g. local-preference 200), so FRR may reject the route map when it is applied.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.warning, validateBGPRouteMapSet(tt.set))
+		})
+	}
+}
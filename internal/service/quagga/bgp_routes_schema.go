@@ -0,0 +1,127 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type bgpRoutesDataSourceModel struct {
+	Routes types.List `tfsdk:"routes"`
+}
+
+type bgpRouteEntryModel struct {
+	Network         types.String `tfsdk:"network"`
+	NextHop         types.String `tfsdk:"next_hop"`
+	ASPath          types.String `tfsdk:"as_path"`
+	Origin          types.String `tfsdk:"origin"`
+	LocalPreference types.Int64  `tfsdk:"local_preference"`
+	Metric          types.Int64  `tfsdk:"metric"`
+	Weight          types.Int64  `tfsdk:"weight"`
+	Valid           types.Bool   `tfsdk:"valid"`
+	Best            types.Bool   `tfsdk:"best"`
+	Protocol        types.String `tfsdk:"protocol"`
+}
+
+var bgpRouteEntryAttrTypes = map[string]attr.Type{
+	"network":          types.StringType,
+	"next_hop":         types.StringType,
+	"as_path":          types.StringType,
+	"origin":           types.StringType,
+	"local_preference": types.Int64Type,
+	"metric":           types.Int64Type,
+	"weight":           types.Int64Type,
+	"valid":            types.BoolType,
+	"best":             types.BoolType,
+	"protocol":         types.StringType,
+}
+
+func bgpRoutesDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "BGP routes can be used to get the routes in the BGP table, including routes learned from neighbors.",
+
+		Attributes: map[string]dschema.Attribute{
+			"routes": dschema.ListNestedAttribute{
+				MarkdownDescription: "A list of all paths in the BGP table. A network learned from several neighbors appears once per path.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"network": dschema.StringAttribute{
+							MarkdownDescription: "The destination network (e.g. `10.0.0.0/24`).",
+							Computed:            true,
+						},
+						"next_hop": dschema.StringAttribute{
+							MarkdownDescription: "The next hop of the path.",
+							Computed:            true,
+						},
+						"as_path": dschema.StringAttribute{
+							MarkdownDescription: "The AS path of the path, e.g. `65001 65002`. Empty for locally originated routes.",
+							Computed:            true,
+						},
+						"origin": dschema.StringAttribute{
+							MarkdownDescription: "The origin of the path. One of `IGP`, `EGP`, `incomplete`.",
+							Computed:            true,
+						},
+						"local_preference": dschema.Int64Attribute{
+							MarkdownDescription: "The local preference of the path. Null if not set.",
+							Computed:            true,
+						},
+						"metric": dschema.Int64Attribute{
+							MarkdownDescription: "The metric (MED) of the path. Null if not set.",
+							Computed:            true,
+						},
+						"weight": dschema.Int64Attribute{
+							MarkdownDescription: "The weight of the path.",
+							Computed:            true,
+						},
+						"valid": dschema.BoolAttribute{
+							MarkdownDescription: "Whether the path is valid.",
+							Computed:            true,
+						},
+						"best": dschema.BoolAttribute{
+							MarkdownDescription: "Whether the path is the best path for the network.",
+							Computed:            true,
+						},
+						"protocol": dschema.StringAttribute{
+							MarkdownDescription: "Protocol family of the route. One of `ipv4`, `ipv6`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertBGPRoutesStructToSchema(d []quagga.BGPRoute) (*bgpRoutesDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	routes := []bgpRouteEntryModel{}
+	for _, elem := range d {
+		routes = append(routes, bgpRouteEntryModel{
+			Network:         types.StringValue(elem.Network),
+			NextHop:         types.StringValue(elem.NextHop),
+			ASPath:          types.StringValue(elem.Path),
+			Origin:          types.StringValue(elem.Origin),
+			LocalPreference: tools.StringToInt64Null(elem.LocalPreference),
+			Metric:          tools.StringToInt64Null(elem.Metric),
+			Weight:          tools.StringToInt64Null(elem.Weight),
+			Valid:           types.BoolValue(tools.StringToBool(elem.Valid)),
+			Best:            types.BoolValue(tools.StringToBool(elem.Best)),
+			Protocol:        types.StringValue(elem.Protocol),
+		})
+	}
+
+	v, _ := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(bgpRouteEntryAttrTypes),
+		routes,
+	)
+
+	return &bgpRoutesDataSourceModel{
+		Routes: v,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package quagga
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertBGPRoutesStructToSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []quagga.BGPRoute
+		expected []bgpRouteEntryModel
+	}{
+		{
+			name:     "empty",
+			input:    []quagga.BGPRoute{},
+			expected: []bgpRouteEntryModel{},
+		},
+		{
+			name: "best_path",
+			input: []quagga.BGPRoute{
+				{
+					Network:         "198.51.100.0/24",
+					NextHop:         "192.0.2.1",
+					Path:            "65001 65002",
+					Origin:          "IGP",
+					LocalPreference: "200",
+					Metric:          "0",
+					Weight:          "0",
+					Valid:           "1",
+					Best:            "1",
+					Protocol:        "ipv4Unicast",
+				},
+			},
+			expected: []bgpRouteEntryModel{
+				{
+					Network:         types.StringValue("198.51.100.0/24"),
+					NextHop:         types.StringValue("192.0.2.1"),
+					ASPath:          types.StringValue("65001 65002"),
+					Origin:          types.StringValue("IGP"),
+					LocalPreference: types.Int64Value(200),
+					Metric:          types.Int64Value(0),
+					Weight:          types.Int64Value(0),
+					Valid:           types.BoolValue(true),
+					Best:            types.BoolValue(true),
+					Protocol:        types.StringValue("ipv4Unicast"),
+				},
+			},
+		},
+		{
+			name: "missing_attributes",
+			input: []quagga.BGPRoute{
+				{
+					Network:  "2001:db8::/32",
+					Origin:   "incomplete",
+					Weight:   "32768",
+					Valid:    "1",
+					Best:     "0",
+					Protocol: "ipv6Unicast",
+				},
+			},
+			expected: []bgpRouteEntryModel{
+				{
+					Network:         types.StringValue("2001:db8::/32"),
+					NextHop:         types.StringValue(""),
+					ASPath:          types.StringValue(""),
+					Origin:          types.StringValue("incomplete"),
+					LocalPreference: types.Int64Null(),
+					Metric:          types.Int64Null(),
+					Weight:          types.Int64Value(32768),
+					Valid:           types.BoolValue(true),
+					Best:            types.BoolValue(false),
+					Protocol:        types.StringValue("ipv6Unicast"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertBGPRoutesStructToSchema(tt.input)
+			assert.NoError(t, err)
+
+			var routes []bgpRouteEntryModel
+			assert.False(t, result.Routes.ElementsAs(context.Background(), &routes, false).HasError())
+			assert.Equal(t, tt.expected, routes)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bgpSettingsResourceModel describes the resource data model.
+type bgpSettingsResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	ASNumber           types.Int64  `tfsdk:"as_number"`
+	RouterID           types.String `tfsdk:"router_id"`
+	Networks           types.Set    `tfsdk:"networks"`
+	Redistribute       types.Set    `tfsdk:"redistribute"`
+	LogNeighborChanges types.Bool   `tfsdk:"log_neighbor_changes"`
+	GracefulRestart    types.Bool   `tfsdk:"graceful_restart"`
+	Multipath          types.Bool   `tfsdk:"multipath"`
+}
+
+func bgpSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the global settings for BGP. This is a singleton, only one instance of this resource should exist. Destroying it disables BGP.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable BGP. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"as_number": schema.Int64Attribute{
+				MarkdownDescription: "The local AS number.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
+				},
+			},
+			"router_id": schema.StringAttribute{
+				MarkdownDescription: "The router ID in dotted decimal notation, e.g. `10.0.0.1`. Leave empty to let FRR choose. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						stringvalidator.RegexMatches(ospfAreaIDRegex, "must be in dotted decimal notation"),
+					),
+				},
+			},
+			"networks": schema.SetAttribute{
+				MarkdownDescription: "Networks to announce, in CIDR notation (e.g. `192.0.2.0/24`). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.CIDR()),
+				},
+			},
+			"redistribute": schema.SetAttribute{
+				MarkdownDescription: "Route sources to redistribute into BGP. Available values: `connected`, `kernel`, `static`, `ospf`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("connected", "kernel", "static", "ospf"),
+					),
+				},
+			},
+			"log_neighbor_changes": schema.BoolAttribute{
+				MarkdownDescription: "Log neighbor up/down changes and resets. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"graceful_restart": schema.BoolAttribute{
+				MarkdownDescription: "Enable graceful restart, so neighbors keep forwarding while BGP restarts. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"multipath": schema.BoolAttribute{
+				MarkdownDescription: "Load balance over equal paths received from different neighboring AS (`bgp bestpath as-path multipath-relax`). Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func bgpSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the global settings for BGP.",
+
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether BGP is enabled.",
+				Computed:            true,
+			},
+			"as_number": dschema.Int64Attribute{
+				MarkdownDescription: "The local AS number.",
+				Computed:            true,
+			},
+			"router_id": dschema.StringAttribute{
+				MarkdownDescription: "The router ID in dotted decimal notation.",
+				Computed:            true,
+			},
+			"networks": dschema.SetAttribute{
+				MarkdownDescription: "Networks announced by BGP.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redistribute": dschema.SetAttribute{
+				MarkdownDescription: "Route sources redistributed into BGP.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"log_neighbor_changes": dschema.BoolAttribute{
+				MarkdownDescription: "Whether neighbor changes are logged.",
+				Computed:            true,
+			},
+			"graceful_restart": dschema.BoolAttribute{
+				MarkdownDescription: "Whether graceful restart is enabled.",
+				Computed:            true,
+			},
+			"multipath": dschema.BoolAttribute{
+				MarkdownDescription: "Whether equal paths from different neighboring AS are load balanced.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertBGPSettingsSchemaToStruct(d *bgpSettingsResourceModel) (*quagga.BGPSettings, error) {
+	return &quagga.BGPSettings{
+		Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+		ASNumber:           tools.Int64ToString(d.ASNumber.ValueInt64()),
+		RouterID:           d.RouterID.ValueString(),
+		Networks:           api.SelectedMapList(tools.SetToStringSlice(d.Networks)),
+		Redistribute:       api.SelectedMapList(tools.SetToStringSlice(d.Redistribute)),
+		LogNeighborChanges: tools.BoolToString(d.LogNeighborChanges.ValueBool()),
+		GracefulRestart:    tools.BoolToString(d.GracefulRestart.ValueBool()),
+		Multipath:          tools.BoolToString(d.Multipath.ValueBool()),
+	}, nil
+}
+
+func convertBGPSettingsStructToSchema(d *quagga.BGPSettings) (*bgpSettingsResourceModel, error) {
+	return &bgpSettingsResourceModel{
+		Enabled:            types.BoolValue(tools.StringToBool(d.Enabled)),
+		ASNumber:           types.Int64Value(tools.StringToInt64(d.ASNumber)),
+		RouterID:           types.StringValue(d.RouterID),
+		Networks:           tools.StringSliceToSet(d.Networks),
+		Redistribute:       tools.StringSliceToSet(d.Redistribute),
+		LogNeighborChanges: types.BoolValue(tools.StringToBool(d.LogNeighborChanges)),
+		GracefulRestart:    types.BoolValue(tools.StringToBool(d.GracefulRestart)),
+		Multipath:          types.BoolValue(tools.StringToBool(d.Multipath)),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertBGPSettingsSchemaToStruct(t *testing.T) {
+	input := &bgpSettingsResourceModel{
+		Enabled:            types.BoolValue(true),
+		ASNumber:           types.Int64Value(65000),
+		RouterID:           types.StringValue("10.0.0.1"),
+		Networks:           tools.StringSliceToSet([]string{"192.0.2.0/24"}),
+		Redistribute:       tools.StringSliceToSet([]string{"connected"}),
+		LogNeighborChanges: types.BoolValue(true),
+		GracefulRestart:    types.BoolValue(false),
+		Multipath:          types.BoolValue(true),
+	}
+
+	expected := &quagga.BGPSettings{
+		Enabled:            "1",
+		ASNumber:           "65000",
+		RouterID:           "10.0.0.1",
+		Networks:           api.SelectedMapList{"192.0.2.0/24"},
+		Redistribute:       api.SelectedMapList{"connected"},
+		LogNeighborChanges: "1",
+		GracefulRestart:    "0",
+		Multipath:          "1",
+	}
+
+	result, err := convertBGPSettingsSchemaToStruct(input)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestConvertBGPSettingsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *bgpSettingsResourceModel
+	}{
+		{
+			name: "defaults",
+			input: &bgpSettingsResourceModel{
+				Enabled:            types.BoolValue(false),
+				ASNumber:           types.Int64Value(0),
+				RouterID:           types.StringValue(""),
+				Networks:           tools.StringSliceToSet([]string{}),
+				Redistribute:       tools.StringSliceToSet([]string{}),
+				LogNeighborChanges: types.BoolValue(false),
+				GracefulRestart:    types.BoolValue(false),
+				Multipath:          types.BoolValue(false),
+			},
+		},
+		{
+			name: "full",
+			input: &bgpSettingsResourceModel{
+				Enabled:            types.BoolValue(true),
+				ASNumber:           types.Int64Value(4200000000),
+				RouterID:           types.StringValue("10.0.0.2"),
+				Networks:           tools.StringSliceToSet([]string{"192.0.2.0/24", "2001:db8::/32"}),
+				Redistribute:       tools.StringSliceToSet([]string{"connected", "static"}),
+				LogNeighborChanges: types.BoolValue(true),
+				GracefulRestart:    types.BoolValue(true),
+				Multipath:          types.BoolValue(true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := convertBGPSettingsSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+
+			result, err := convertBGPSettingsStructToSchema(settings)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input, result)
+		})
+	}
+}
+
+func TestBGPSettingsSchemaValidation(t *testing.T) {
+	schema := bgpSettingsResourceSchema()
+
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "", valid: true},
+		{value: "10.0.0.1", valid: true},
+		{value: "10.0.0", valid: false},
+		{value: "router1", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes["router_id"], tt.value))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package quagga
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type bgpSummaryDataSourceModel struct {
+	RouterID       types.String `tfsdk:"router_id"`
+	ASNumber       types.Int64  `tfsdk:"as_number"`
+	AllEstablished types.Bool   `tfsdk:"all_established"`
+	Peers          types.List   `tfsdk:"peers"`
+}
+
+type bgpSummaryPeerModel struct {
+	Neighbor         types.String `tfsdk:"neighbor"`
+	AddressFamily    types.String `tfsdk:"address_family"`
+	Description      types.String `tfsdk:"description"`
+	RemoteAS         types.Int64  `tfsdk:"remote_as"`
+	State            types.String `tfsdk:"state"`
+	Uptime           types.String `tfsdk:"uptime"`
+	UptimeSeconds    types.Int64  `tfsdk:"uptime_seconds"`
+	PrefixesReceived types.Int64  `tfsdk:"prefixes_received"`
+	PrefixesSent     types.Int64  `tfsdk:"prefixes_sent"`
+}
+
+var bgpSummaryPeerAttrTypes = map[string]attr.Type{
+	"neighbor":          types.StringType,
+	"address_family":    types.StringType,
+	"description":       types.StringType,
+	"remote_as":         types.Int64Type,
+	"state":             types.StringType,
+	"uptime":            types.StringType,
+	"uptime_seconds":    types.Int64Type,
+	"prefixes_received": types.Int64Type,
+	"prefixes_sent":     types.Int64Type,
+}
+
+func bgpSummaryDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "BGP summary can be used to get the session state of all BGP neighbors, as shown under **Routing → Diagnostics → BGP**.",
+
+		Attributes: map[string]dschema.Attribute{
+			"router_id": dschema.StringAttribute{
+				MarkdownDescription: "The router ID used by BGP.",
+				Computed:            true,
+			},
+			"as_number": dschema.Int64Attribute{
+				MarkdownDescription: "The local AS number.",
+				Computed:            true,
+			},
+			"all_established": dschema.BoolAttribute{
+				MarkdownDescription: "Whether every session in `peers` is in the `Established` state. `false` if there are no sessions.",
+				Computed:            true,
+			},
+			"peers": dschema.ListNestedAttribute{
+				MarkdownDescription: "A list of all BGP sessions, one per neighbor and address family.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"neighbor": dschema.StringAttribute{
+							MarkdownDescription: "The address of the neighbor.",
+							Computed:            true,
+						},
+						"address_family": dschema.StringAttribute{
+							MarkdownDescription: "The address family of the session, e.g. `ipv4Unicast`.",
+							Computed:            true,
+						},
+						"description": dschema.StringAttribute{
+							MarkdownDescription: "The description of the neighbor.",
+							Computed:            true,
+						},
+						"remote_as": dschema.Int64Attribute{
+							MarkdownDescription: "The AS of the neighbor.",
+							Computed:            true,
+						},
+						"state": dschema.StringAttribute{
+							MarkdownDescription: "The session state, e.g. `Established`, `Active`, `Connect` or `Idle`.",
+							Computed:            true,
+						},
+						"uptime": dschema.StringAttribute{
+							MarkdownDescription: "How long the session has been in its current state, e.g. `01:02:03`.",
+							Computed:            true,
+						},
+						"uptime_seconds": dschema.Int64Attribute{
+							MarkdownDescription: "How long the session has been in its current state, in seconds.",
+							Computed:            true,
+						},
+						"prefixes_received": dschema.Int64Attribute{
+							MarkdownDescription: "The number of prefixes received from the neighbor. Null if the session is not established.",
+							Computed:            true,
+						},
+						"prefixes_sent": dschema.Int64Attribute{
+							MarkdownDescription: "The number of prefixes sent to the neighbor. Null if the session is not established.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertBGPSummaryStructToSchema(d *quagga.BGPSummary) (*bgpSummaryDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	peers := []bgpSummaryPeerModel{}
+	allEstablished := len(d.Peers) > 0
+	for _, elem := range d.Peers {
+		if elem.State != "Established" {
+			allEstablished = false
+		}
+
+		peers = append(peers, bgpSummaryPeerModel{
+			Neighbor:         types.StringValue(elem.Neighbor),
+			AddressFamily:    types.StringValue(elem.AddressFamily),
+			Description:      types.StringValue(elem.Description),
+			RemoteAS:         tools.StringToInt64Null(elem.RemoteAS),
+			State:            types.StringValue(elem.State),
+			Uptime:           types.StringValue(elem.Uptime),
+			UptimeSeconds:    tools.StringToInt64Null(elem.UptimeSeconds),
+			PrefixesReceived: tools.StringToInt64Null(elem.PrefixesReceived),
+			PrefixesSent:     tools.StringToInt64Null(elem.PrefixesSent),
+		})
+	}
+
+	v, _ := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(bgpSummaryPeerAttrTypes),
+		peers,
+	)
+
+	return &bgpSummaryDataSourceModel{
+		RouterID:       types.StringValue(d.RouterID),
+		ASNumber:       tools.StringToInt64Null(d.ASNumber),
+		AllEstablished: types.BoolValue(allEstablished),
+		Peers:          v,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package quagga
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertBGPSummaryStructToSchema(t *testing.T) {
+	summary := &quagga.BGPSummary{
+		RouterID: "10.0.0.1",
+		ASNumber: "65000",
+		Peers: []quagga.BGPSummaryPeer{
+			{
+				Neighbor:         "192.0.2.1",
+				AddressFamily:    "ipv4Unicast",
+				Description:      "upstream",
+				RemoteAS:         "65001",
+				State:            "Established",
+				Uptime:           "01:02:03",
+				UptimeSeconds:    "3723",
+				PrefixesReceived: "10",
+				PrefixesSent:     "2",
+			},
+		},
+	}
+
+	result, err := convertBGPSummaryStructToSchema(summary)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue("10.0.0.1"), result.RouterID)
+	assert.Equal(t, types.Int64Value(65000), result.ASNumber)
+
+	var peers []bgpSummaryPeerModel
+	assert.False(t, result.Peers.ElementsAs(context.Background(), &peers, false).HasError())
+	assert.Equal(t, []bgpSummaryPeerModel{
+		{
+			Neighbor:         types.StringValue("192.0.2.1"),
+			AddressFamily:    types.StringValue("ipv4Unicast"),
+			Description:      types.StringValue("upstream"),
+			RemoteAS:         types.Int64Value(65001),
+			State:            types.StringValue("Established"),
+			Uptime:           types.StringValue("01:02:03"),
+			UptimeSeconds:    types.Int64Value(3723),
+			PrefixesReceived: types.Int64Value(10),
+			PrefixesSent:     types.Int64Value(2),
+		},
+	}, peers)
+}
+
+func TestConvertBGPSummaryAllEstablished(t *testing.T) {
+	tests := []struct {
+		name     string
+		states   []string
+		expected bool
+	}{
+		{name: "no_peers", states: []string{}, expected: false},
+		{name: "all_established", states: []string{"Established", "Established"}, expected: true},
+		{name: "one_down", states: []string{"Established", "Active"}, expected: false},
+		{name: "none_established", states: []string{"Idle", "Connect"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := &quagga.BGPSummary{Peers: []quagga.BGPSummaryPeer{}}
+			for _, state := range tt.states {
+				summary.Peers = append(summary.Peers, quagga.BGPSummaryPeer{Neighbor: "192.0.2.1", State: state})
+			}
+
+			result, err := convertBGPSummaryStructToSchema(summary)
+			assert.NoError(t, err)
+			assert.Equal(t, types.BoolValue(tt.expected), result.AllEstablished)
+			assert.Len(t, result.Peers.Elements(), len(tt.states))
+		})
+	}
+}
+
+func TestConvertBGPSummaryMissingCounters(t *testing.T) {
+	summary := &quagga.BGPSummary{
+		Peers: []quagga.BGPSummaryPeer{
+			{Neighbor: "192.0.2.1", AddressFamily: "ipv4Unicast", State: "Active"},
+		},
+	}
+
+	result, err := convertBGPSummaryStructToSchema(summary)
+	assert.NoError(t, err)
+	assert.True(t, result.ASNumber.IsNull())
+
+	var peers []bgpSummaryPeerModel
+	assert.False(t, result.Peers.ElementsAs(context.Background(), &peers, false).HasError())
+	assert.True(t, peers[0].RemoteAS.IsNull())
+	assert.True(t, peers[0].UptimeSeconds.IsNull())
+	assert.True(t, peers[0].PrefixesReceived.IsNull())
+	assert.True(t, peers[0].PrefixesSent.IsNull())
+}
@@ -9,20 +9,49 @@ import (
 
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		newBFDPeerResource,
 		newBGPASPathResource,
 		newBGPCommunityListResource,
 		newBGPNeighborResource,
+		newBGPPeerGroupResource,
 		newBGPPrefixListResource,
+		newBGPPrefixListSetResource,
 		newBGPRouteMapResource,
+		newBGPSettingsResource,
+		newGeneralResource,
+		newOSPFSettingsResource,
+		newOSPFAreaResource,
+		newOSPFNetworkResource,
+		newOSPFInterfaceResource,
+		newOSPF6SettingsResource,
+		newOSPF6AreaResource,
+		newOSPF6NetworkResource,
+		newOSPF6InterfaceResource,
+		newStaticRouteResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newBFDPeerDataSource,
 		newBGPASPathDataSource,
 		newBGPCommunityListDataSource,
 		newBGPNeighborDataSource,
+		newBGPPeerGroupDataSource,
 		newBGPPrefixListDataSource,
 		newBGPRouteMapDataSource,
+		newBGPRoutesDataSource,
+		newBGPSettingsDataSource,
+		newBGPSummaryDataSource,
+		newGeneralDataSource,
+		newOSPFSettingsDataSource,
+		newOSPFAreaDataSource,
+		newOSPFNetworkDataSource,
+		newOSPFInterfaceDataSource,
+		newOSPF6SettingsDataSource,
+		newOSPF6AreaDataSource,
+		newOSPF6NetworkDataSource,
+		newOSPF6InterfaceDataSource,
+		newStaticRouteDataSource,
 	}
 }
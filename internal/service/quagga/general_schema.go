@@ -0,0 +1,146 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// generalResourceModel describes the resource data model.
+type generalResourceModel struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Profile        types.String `tfsdk:"profile"`
+	CARPAware      types.Bool   `tfsdk:"carp_aware"`
+	Syslog         types.Bool   `tfsdk:"syslog"`
+	LogLevel       types.String `tfsdk:"log_level"`
+	SyslogFacility types.String `tfsdk:"syslog_facility"`
+	FirewallRules  types.Bool   `tfsdk:"firewall_rules"`
+}
+
+func generalResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the FRR routing daemon. This is a singleton, only one instance of this resource should exist. Destroying it disables FRR.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the routing daemon. Routing protocols only run while this is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The FRR defaults profile. `datacenter` uses faster timers, suited for networks with low latency. Available values: `traditional`, `datacenter`. Defaults to `\"traditional\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("traditional"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("traditional", "datacenter"),
+				},
+			},
+			"carp_aware": schema.BoolAttribute{
+				MarkdownDescription: "Only run the routing daemon on the CARP master, and stop it when this node becomes backup. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"syslog": schema.BoolAttribute{
+				MarkdownDescription: "Send routing daemon logs to syslog. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"log_level": schema.StringAttribute{
+				MarkdownDescription: "The minimum severity of messages sent to syslog. Available values: `emergencies`, `alerts`, `critical`, `errors`, `warnings`, `notifications`, `informational`, `debugging`. Defaults to `\"notifications\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("notifications"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("emergencies", "alerts", "critical", "errors", "warnings", "notifications", "informational", "debugging"),
+				},
+			},
+			"syslog_facility": schema.StringAttribute{
+				MarkdownDescription: "The syslog facility used for routing daemon logs. Available values: `daemon`, `local0` through `local7`. Defaults to `\"daemon\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("daemon"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("daemon", "local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7"),
+				},
+			},
+			"firewall_rules": schema.BoolAttribute{
+				MarkdownDescription: "Automatically add firewall rules allowing the enabled routing protocols (e.g. BGP, OSPF). Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func generalDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of the FRR routing daemon.",
+
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the routing daemon is enabled.",
+				Computed:            true,
+			},
+			"profile": dschema.StringAttribute{
+				MarkdownDescription: "The FRR defaults profile.",
+				Computed:            true,
+			},
+			"carp_aware": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the routing daemon only runs on the CARP master.",
+				Computed:            true,
+			},
+			"syslog": dschema.BoolAttribute{
+				MarkdownDescription: "Whether routing daemon logs are sent to syslog.",
+				Computed:            true,
+			},
+			"log_level": dschema.StringAttribute{
+				MarkdownDescription: "The minimum severity of messages sent to syslog.",
+				Computed:            true,
+			},
+			"syslog_facility": dschema.StringAttribute{
+				MarkdownDescription: "The syslog facility used for routing daemon logs.",
+				Computed:            true,
+			},
+			"firewall_rules": dschema.BoolAttribute{
+				MarkdownDescription: "Whether firewall rules for the enabled routing protocols are added automatically.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertGeneralSchemaToStruct(d *generalResourceModel) (*quagga.GeneralSettings, error) {
+	return &quagga.GeneralSettings{
+		Enabled:        tools.BoolToString(d.Enabled.ValueBool()),
+		Profile:        api.SelectedMap(d.Profile.ValueString()),
+		CARPAware:      tools.BoolToString(d.CARPAware.ValueBool()),
+		Syslog:         tools.BoolToString(d.Syslog.ValueBool()),
+		LogLevel:       api.SelectedMap(d.LogLevel.ValueString()),
+		SyslogFacility: api.SelectedMap(d.SyslogFacility.ValueString()),
+		FirewallRules:  tools.BoolToString(d.FirewallRules.ValueBool()),
+	}, nil
+}
+
+func convertGeneralStructToSchema(d *quagga.GeneralSettings) (*generalResourceModel, error) {
+	return &generalResourceModel{
+		Enabled:        types.BoolValue(tools.StringToBool(d.Enabled)),
+		Profile:        types.StringValue(d.Profile.String()),
+		CARPAware:      types.BoolValue(tools.StringToBool(d.CARPAware)),
+		Syslog:         types.BoolValue(tools.StringToBool(d.Syslog)),
+		LogLevel:       types.StringValue(d.LogLevel.String()),
+		SyslogFacility: types.StringValue(d.SyslogFacility.String()),
+		FirewallRules:  types.BoolValue(tools.StringToBool(d.FirewallRules)),
+	}, nil
+}
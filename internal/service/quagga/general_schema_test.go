@@ -0,0 +1,78 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertGeneralSchemaToStruct(t *testing.T) {
+	input := &generalResourceModel{
+		Enabled:        types.BoolValue(true),
+		Profile:        types.StringValue("traditional"),
+		CARPAware:      types.BoolValue(false),
+		Syslog:         types.BoolValue(true),
+		LogLevel:       types.StringValue("notifications"),
+		SyslogFacility: types.StringValue("daemon"),
+		FirewallRules:  types.BoolValue(true),
+	}
+
+	expected := &quagga.GeneralSettings{
+		Enabled:        "1",
+		Profile:        api.SelectedMap("traditional"),
+		CARPAware:      "0",
+		Syslog:         "1",
+		LogLevel:       api.SelectedMap("notifications"),
+		SyslogFacility: api.SelectedMap("daemon"),
+		FirewallRules:  "1",
+	}
+
+	result, err := convertGeneralSchemaToStruct(input)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestConvertGeneralRoundTrip(t *testing.T) {
+	original := &generalResourceModel{
+		Enabled:        types.BoolValue(true),
+		Profile:        types.StringValue("datacenter"),
+		CARPAware:      types.BoolValue(true),
+		Syslog:         types.BoolValue(false),
+		LogLevel:       types.StringValue("debugging"),
+		SyslogFacility: types.StringValue("local3"),
+		FirewallRules:  types.BoolValue(false),
+	}
+
+	settings, err := convertGeneralSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertGeneralStructToSchema(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestGeneralSchemaValidation(t *testing.T) {
+	schema := generalResourceSchema()
+
+	tests := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{attribute: "profile", value: "datacenter", valid: true},
+		{attribute: "profile", value: "enterprise", valid: false},
+		{attribute: "log_level", value: "warnings", valid: true},
+		{attribute: "log_level", value: "warning", valid: false},
+		{attribute: "syslog_facility", value: "local7", valid: true},
+		{attribute: "syslog_facility", value: "local8", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"_"+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes[tt.attribute], tt.value))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ospf6AreaResourceModel describes the resource data model.
+type ospf6AreaResourceModel struct {
+	AreaID      types.String `tfsdk:"area_id"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ospf6AreaResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure areas for OSPFv3.",
+
+		Attributes: map[string]schema.Attribute{
+			"area_id": schema.StringAttribute{
+				MarkdownDescription: "The area ID in dotted decimal notation, e.g. `0.0.0.1`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ospfAreaIDRegex, "must be in dotted decimal notation"),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The area type. Set to `\"\"` for a normal area, `stub` or `nssa` for stub/not-so-stubby areas, and `stub no-summary` or `nssa no-summary` to also suppress inter-area prefix LSAs. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "stub", "stub no-summary", "nssa", "nssa no-summary"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this area. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the area.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func ospf6AreaDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure areas for OSPFv3.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"area_id": dschema.StringAttribute{
+				MarkdownDescription: "The area ID in dotted decimal notation.",
+				Computed:            true,
+			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "The area type.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this area.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertOSPF6AreaSchemaToStruct(d *ospf6AreaResourceModel) (*quagga.OSPF6Area, error) {
+	return &quagga.OSPF6Area{
+		AreaID:      d.AreaID.ValueString(),
+		Type:        api.SelectedMap(d.Type.ValueString()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertOSPF6AreaStructToSchema(d *quagga.OSPF6Area) (*ospf6AreaResourceModel, error) {
+	return &ospf6AreaResourceModel{
+		AreaID:      types.StringValue(d.AreaID),
+		Type:        types.StringValue(d.Type.String()),
+		Description: types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertOSPF6AreaSchemaToStruct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *ospf6AreaResourceModel
+		expected *quagga.OSPF6Area
+	}{
+		{
+			name: "backbone",
+			input: &ospf6AreaResourceModel{
+				AreaID:      types.StringValue("0.0.0.0"),
+				Type:        types.StringValue(""),
+				Description: types.StringValue("Backbone"),
+				Id:          types.StringValue("area-id"),
+			},
+			expected: &quagga.OSPF6Area{
+				AreaID:      "0.0.0.0",
+				Type:        api.SelectedMap(""),
+				Description: "Backbone",
+			},
+		},
+		{
+			name: "totally_stubby",
+			input: &ospf6AreaResourceModel{
+				AreaID:      types.StringValue("0.0.0.10"),
+				Type:        types.StringValue("stub no-summary"),
+				Description: types.StringValue(""),
+			},
+			expected: &quagga.OSPF6Area{
+				AreaID:      "0.0.0.10",
+				Type:        api.SelectedMap("stub no-summary"),
+				Description: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertOSPF6AreaSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestConvertOSPF6AreaRoundTrip(t *testing.T) {
+	original := &ospf6AreaResourceModel{
+		AreaID:      types.StringValue("0.0.0.1"),
+		Type:        types.StringValue("nssa"),
+		Description: types.StringValue("Branch offices"),
+	}
+
+	area, err := convertOSPF6AreaSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertOSPF6AreaStructToSchema(area)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestOSPF6AreaSchemaValidation(t *testing.T) {
+	schema := ospf6AreaResourceSchema()
+
+	assert.True(t, schema.Attributes["area_id"].IsRequired())
+	assert.True(t, schema.Attributes["id"].IsComputed())
+
+	tests := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{attribute: "area_id", value: "0.0.0.0", valid: true},
+		{attribute: "area_id", value: "0", valid: false},
+		{attribute: "type", value: "nssa no-summary", valid: true},
+		{attribute: "type", value: "totally-stubby", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"_"+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes[tt.attribute], tt.value))
+		})
+	}
+}
+
+func TestOSPF6AreaDataSourceSchema(t *testing.T) {
+	schema := ospf6AreaDataSourceSchema()
+
+	for _, field := range []string{"area_id", "type", "description"} {
+		attr := schema.Attributes[field]
+		assert.NotNil(t, attr, "Field %s should exist", field)
+		assert.True(t, attr.IsComputed(), "Field %s should be computed", field)
+	}
+	assert.True(t, schema.Attributes["id"].IsRequired())
+}
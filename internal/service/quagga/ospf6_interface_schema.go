@@ -0,0 +1,249 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ospf6InterfaceResourceModel describes the resource data model.
+type ospf6InterfaceResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Interface          types.String `tfsdk:"interface"`
+	Area               types.String `tfsdk:"area"`
+	NetworkType        types.String `tfsdk:"network_type"`
+	Passive            types.Bool   `tfsdk:"passive"`
+	Cost               types.Int64  `tfsdk:"cost"`
+	Priority           types.Int64  `tfsdk:"priority"`
+	HelloInterval      types.Int64  `tfsdk:"hello_interval"`
+	DeadInterval       types.Int64  `tfsdk:"dead_interval"`
+	RetransmitInterval types.Int64  `tfsdk:"retransmit_interval"`
+	TransmitDelay      types.Int64  `tfsdk:"transmit_delay"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ospf6InterfaceResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure per-interface settings for OSPFv3.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this interface. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface these settings apply to. Must be a valid OPNsense interface in lowercase (e.g. `lan`).",
+				Required:            true,
+			},
+			"area": schema.StringAttribute{
+				MarkdownDescription: "The area ID in dotted decimal notation to place this interface in. Leave empty to rely on the matching `opnsense_quagga_ospf6_network`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						stringvalidator.RegexMatches(ospfAreaIDRegex, "must be in dotted decimal notation"),
+					),
+				},
+			},
+			"network_type": schema.StringAttribute{
+				MarkdownDescription: "The OSPFv3 network type of the interface. Leave empty to detect it from the interface. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "broadcast", "point-to-multipoint", "point-to-point"),
+				},
+			},
+			"passive": schema.BoolAttribute{
+				MarkdownDescription: "Advertise the interface network but do not send or receive OSPFv3 packets on it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "The link cost of the interface. Set to `-1` to derive it from the interface bandwidth. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "The router priority used in the designated router election. Set to `-1` to use the FRR default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(0, 255),
+					),
+				},
+			},
+			"hello_interval": schema.Int64Attribute{
+				MarkdownDescription: "Seconds between hello packets. Set to `-1` to use the FRR default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"dead_interval": schema.Int64Attribute{
+				MarkdownDescription: "Seconds without hello packets after which a neighbor is considered down. Set to `-1` to use the FRR default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"retransmit_interval": schema.Int64Attribute{
+				MarkdownDescription: "Seconds between retransmissions of link state advertisements. Set to `-1` to use the FRR default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"transmit_delay": schema.Int64Attribute{
+				MarkdownDescription: "Estimated seconds needed to send a link state update. Set to `-1` to use the FRR default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the interface.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func ospf6InterfaceDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure per-interface settings for OSPFv3.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this interface is enabled.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface these settings apply to.",
+				Computed:            true,
+			},
+			"area": dschema.StringAttribute{
+				MarkdownDescription: "The area ID this interface is placed in.",
+				Computed:            true,
+			},
+			"network_type": dschema.StringAttribute{
+				MarkdownDescription: "The OSPFv3 network type of the interface.",
+				Computed:            true,
+			},
+			"passive": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the interface is passive.",
+				Computed:            true,
+			},
+			"cost": dschema.Int64Attribute{
+				MarkdownDescription: "The link cost of the interface.",
+				Computed:            true,
+			},
+			"priority": dschema.Int64Attribute{
+				MarkdownDescription: "The router priority used in the designated router election.",
+				Computed:            true,
+			},
+			"hello_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Seconds between hello packets.",
+				Computed:            true,
+			},
+			"dead_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Seconds without hello packets after which a neighbor is considered down.",
+				Computed:            true,
+			},
+			"retransmit_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Seconds between retransmissions of link state advertisements.",
+				Computed:            true,
+			},
+			"transmit_delay": dschema.Int64Attribute{
+				MarkdownDescription: "Estimated seconds needed to send a link state update.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertOSPF6InterfaceSchemaToStruct(d *ospf6InterfaceResourceModel) (*quagga.OSPF6Interface, error) {
+	return &quagga.OSPF6Interface{
+		Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+		Interface:          api.SelectedMap(d.Interface.ValueString()),
+		Area:               d.Area.ValueString(),
+		NetworkType:        api.SelectedMap(d.NetworkType.ValueString()),
+		Passive:            tools.BoolToString(d.Passive.ValueBool()),
+		Cost:               tools.Int64ToStringNegative(d.Cost.ValueInt64()),
+		Priority:           tools.Int64ToStringNegative(d.Priority.ValueInt64()),
+		HelloInterval:      tools.Int64ToStringNegative(d.HelloInterval.ValueInt64()),
+		DeadInterval:       tools.Int64ToStringNegative(d.DeadInterval.ValueInt64()),
+		RetransmitInterval: tools.Int64ToStringNegative(d.RetransmitInterval.ValueInt64()),
+		TransmitDelay:      tools.Int64ToStringNegative(d.TransmitDelay.ValueInt64()),
+	}, nil
+}
+
+func convertOSPF6InterfaceStructToSchema(d *quagga.OSPF6Interface) (*ospf6InterfaceResourceModel, error) {
+	return &ospf6InterfaceResourceModel{
+		Enabled:            types.BoolValue(tools.StringToBool(d.Enabled)),
+		Interface:          types.StringValue(d.Interface.String()),
+		Area:               types.StringValue(d.Area),
+		NetworkType:        types.StringValue(d.NetworkType.String()),
+		Passive:            types.BoolValue(tools.StringToBool(d.Passive)),
+		Cost:               types.Int64Value(tools.StringToInt64(d.Cost)),
+		Priority:           types.Int64Value(tools.StringToInt64(d.Priority)),
+		HelloInterval:      types.Int64Value(tools.StringToInt64(d.HelloInterval)),
+		DeadInterval:       types.Int64Value(tools.StringToInt64(d.DeadInterval)),
+		RetransmitInterval: types.Int64Value(tools.StringToInt64(d.RetransmitInterval)),
+		TransmitDelay:      types.Int64Value(tools.StringToInt64(d.TransmitDelay)),
+	}, nil
+}
@@ -0,0 +1,157 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ospf6NetworkResourceModel describes the resource data model.
+type ospf6NetworkResourceModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Address       types.String `tfsdk:"address"`
+	Mask          types.Int64  `tfsdk:"mask"`
+	Area          types.String `tfsdk:"area"`
+	AreaRange     types.String `tfsdk:"area_range"`
+	PrefixListIn  types.String `tfsdk:"prefix_list_in"`
+	PrefixListOut types.String `tfsdk:"prefix_list_out"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ospf6NetworkResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure networks for OSPFv3. Interfaces with an address inside a network take part in OSPFv3 for the given area.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this network. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The network address, e.g. `2001:db8::`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"mask": schema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the network, e.g. `64`.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 128),
+				},
+			},
+			"area": schema.StringAttribute{
+				MarkdownDescription: "The area ID in dotted decimal notation, e.g. `0.0.0.0`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ospfAreaIDRegex, "must be in dotted decimal notation"),
+				},
+			},
+			"area_range": schema.StringAttribute{
+				MarkdownDescription: "Summarize the routes of this area into the given range when advertising them to other areas, e.g. `2001:db8::/48`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prefix_list_in": schema.StringAttribute{
+				MarkdownDescription: "The prefix list ID used to filter routes imported into this area, e.g. `opnsense_quagga_bgp_prefixlist.example.id`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prefix_list_out": schema.StringAttribute{
+				MarkdownDescription: "The prefix list ID used to filter routes exported from this area. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func ospf6NetworkDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure networks for OSPFv3. Interfaces with an address inside a network take part in OSPFv3 for the given area.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this network is enabled.",
+				Computed:            true,
+			},
+			"address": dschema.StringAttribute{
+				MarkdownDescription: "The network address.",
+				Computed:            true,
+			},
+			"mask": dschema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the network.",
+				Computed:            true,
+			},
+			"area": dschema.StringAttribute{
+				MarkdownDescription: "The area ID in dotted decimal notation.",
+				Computed:            true,
+			},
+			"area_range": dschema.StringAttribute{
+				MarkdownDescription: "The range routes of this area are summarized into.",
+				Computed:            true,
+			},
+			"prefix_list_in": dschema.StringAttribute{
+				MarkdownDescription: "The prefix list ID used to filter routes imported into this area.",
+				Computed:            true,
+			},
+			"prefix_list_out": dschema.StringAttribute{
+				MarkdownDescription: "The prefix list ID used to filter routes exported from this area.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertOSPF6NetworkSchemaToStruct(d *ospf6NetworkResourceModel) (*quagga.OSPF6Network, error) {
+	return &quagga.OSPF6Network{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Address:       d.Address.ValueString(),
+		Mask:          tools.Int64ToString(d.Mask.ValueInt64()),
+		Area:          d.Area.ValueString(),
+		AreaRange:     d.AreaRange.ValueString(),
+		PrefixListIn:  api.SelectedMap(d.PrefixListIn.ValueString()),
+		PrefixListOut: api.SelectedMap(d.PrefixListOut.ValueString()),
+	}, nil
+}
+
+func convertOSPF6NetworkStructToSchema(d *quagga.OSPF6Network) (*ospf6NetworkResourceModel, error) {
+	return &ospf6NetworkResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		Address:       types.StringValue(d.Address),
+		Mask:          types.Int64Value(tools.StringToInt64(d.Mask)),
+		Area:          types.StringValue(d.Area),
+		AreaRange:     types.StringValue(d.AreaRange),
+		PrefixListIn:  types.StringValue(d.PrefixListIn.String()),
+		PrefixListOut: types.StringValue(d.PrefixListOut.String()),
+	}, nil
+}
@@ -0,0 +1,126 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertOSPF6NetworkSchemaToStruct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *ospf6NetworkResourceModel
+		expected *quagga.OSPF6Network
+	}{
+		{
+			name: "basic_conversion",
+			input: &ospf6NetworkResourceModel{
+				Enabled:       types.BoolValue(true),
+				Address:       types.StringValue("2001:db8::"),
+				Mask:          types.Int64Value(64),
+				Area:          types.StringValue("0.0.0.0"),
+				AreaRange:     types.StringValue(""),
+				PrefixListIn:  types.StringValue(""),
+				PrefixListOut: types.StringValue(""),
+				Id:            types.StringValue("network-id"),
+			},
+			expected: &quagga.OSPF6Network{
+				Enabled:       "1",
+				Address:       "2001:db8::",
+				Mask:          "64",
+				Area:          "0.0.0.0",
+				AreaRange:     "",
+				PrefixListIn:  api.SelectedMap(""),
+				PrefixListOut: api.SelectedMap(""),
+			},
+		},
+		{
+			name: "area_range_and_prefix_lists",
+			input: &ospf6NetworkResourceModel{
+				Enabled:       types.BoolValue(false),
+				Address:       types.StringValue("2001:db8:10::"),
+				Mask:          types.Int64Value(56),
+				Area:          types.StringValue("0.0.0.1"),
+				AreaRange:     types.StringValue("2001:db8::/48"),
+				PrefixListIn:  types.StringValue("in-uuid"),
+				PrefixListOut: types.StringValue("out-uuid"),
+			},
+			expected: &quagga.OSPF6Network{
+				Enabled:       "0",
+				Address:       "2001:db8:10::",
+				Mask:          "56",
+				Area:          "0.0.0.1",
+				AreaRange:     "2001:db8::/48",
+				PrefixListIn:  api.SelectedMap("in-uuid"),
+				PrefixListOut: api.SelectedMap("out-uuid"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertOSPF6NetworkSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestConvertOSPF6NetworkRoundTrip(t *testing.T) {
+	original := &ospf6NetworkResourceModel{
+		Enabled:       types.BoolValue(true),
+		Address:       types.StringValue("2001:db8:20::"),
+		Mask:          types.Int64Value(64),
+		Area:          types.StringValue("0.0.0.2"),
+		AreaRange:     types.StringValue("2001:db8:20::/48"),
+		PrefixListIn:  types.StringValue("in-uuid"),
+		PrefixListOut: types.StringValue(""),
+	}
+
+	network, err := convertOSPF6NetworkSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertOSPF6NetworkStructToSchema(network)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestOSPF6NetworkSchemaValidation(t *testing.T) {
+	schema := ospf6NetworkResourceSchema()
+
+	for _, field := range []string{"address", "mask", "area"} {
+		assert.True(t, schema.Attributes[field].IsRequired(), "Field %s should be required", field)
+	}
+
+	tests := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{attribute: "address", value: "2001:db8::", valid: true},
+		{attribute: "address", value: "2001:db8::/64", valid: false},
+		{attribute: "address", value: "not-an-address", valid: false},
+		{attribute: "area", value: "0.0.0.0", valid: true},
+		{attribute: "area", value: "backbone", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"_"+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes[tt.attribute], tt.value))
+		})
+	}
+}
+
+func TestOSPF6NetworkDataSourceSchema(t *testing.T) {
+	schema := ospf6NetworkDataSourceSchema()
+
+	for _, field := range []string{"enabled", "address", "mask", "area", "area_range", "prefix_list_in", "prefix_list_out"} {
+		attr := schema.Attributes[field]
+		assert.NotNil(t, attr, "Field %s should exist", field)
+		assert.True(t, attr.IsComputed(), "Field %s should be computed", field)
+	}
+	assert.True(t, schema.Attributes["id"].IsRequired())
+}
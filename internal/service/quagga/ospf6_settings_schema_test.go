@@ -0,0 +1,145 @@
+package quagga
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validateStringAttribute reports whether value passes the validators of a string attribute.
+func validateStringAttribute(t *testing.T, attr schema.Attribute, value string) bool {
+	stringAttr, ok := attr.(schema.StringAttribute)
+	require.True(t, ok, "attribute should be a string attribute")
+
+	resp := &validator.StringResponse{}
+	for _, v := range stringAttr.Validators {
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("attribute"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+	}
+	return !resp.Diagnostics.HasError()
+}
+
+func TestConvertOSPF6SettingsSchemaToStruct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *ospf6SettingsResourceModel
+		expected *quagga.OSPF6Settings
+	}{
+		{
+			name: "defaults",
+			input: &ospf6SettingsResourceModel{
+				Enabled:                types.BoolValue(false),
+				RouterID:               types.StringValue(""),
+				PassiveDefault:         types.BoolValue(false),
+				Redistribute:           tools.StringSliceToSet([]string{}),
+				RedistributeRouteMap:   types.StringValue(""),
+				DefaultOriginate:       types.BoolValue(false),
+				DefaultOriginateAlways: types.BoolValue(false),
+				DefaultOriginateMetric: types.Int64Value(-1),
+			},
+			expected: &quagga.OSPF6Settings{
+				Enabled:                "0",
+				RouterID:               "",
+				PassiveDefault:         "0",
+				Redistribute:           api.SelectedMapList{},
+				RedistributeRouteMap:   api.SelectedMap(""),
+				DefaultOriginate:       "0",
+				DefaultOriginateAlways: "0",
+				DefaultOriginateMetric: "",
+			},
+		},
+		{
+			name: "redistribute_and_originate",
+			input: &ospf6SettingsResourceModel{
+				Enabled:                types.BoolValue(true),
+				RouterID:               types.StringValue("10.0.0.1"),
+				PassiveDefault:         types.BoolValue(true),
+				Redistribute:           tools.StringSliceToSet([]string{"connected"}),
+				RedistributeRouteMap:   types.StringValue("map-uuid"),
+				DefaultOriginate:       types.BoolValue(true),
+				DefaultOriginateAlways: types.BoolValue(true),
+				DefaultOriginateMetric: types.Int64Value(100),
+			},
+			expected: &quagga.OSPF6Settings{
+				Enabled:                "1",
+				RouterID:               "10.0.0.1",
+				PassiveDefault:         "1",
+				Redistribute:           api.SelectedMapList{"connected"},
+				RedistributeRouteMap:   api.SelectedMap("map-uuid"),
+				DefaultOriginate:       "1",
+				DefaultOriginateAlways: "1",
+				DefaultOriginateMetric: "100",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertOSPF6SettingsSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestConvertOSPF6SettingsRoundTrip(t *testing.T) {
+	original := &ospf6SettingsResourceModel{
+		Enabled:                types.BoolValue(true),
+		RouterID:               types.StringValue("10.0.0.2"),
+		PassiveDefault:         types.BoolValue(false),
+		Redistribute:           tools.StringSliceToSet([]string{"connected", "static"}),
+		RedistributeRouteMap:   types.StringValue(""),
+		DefaultOriginate:       types.BoolValue(true),
+		DefaultOriginateAlways: types.BoolValue(false),
+		DefaultOriginateMetric: types.Int64Value(-1),
+	}
+
+	settings, err := convertOSPF6SettingsSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertOSPF6SettingsStructToSchema(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestOSPF6SettingsSchemaValidation(t *testing.T) {
+	schema := ospf6SettingsResourceSchema()
+
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "", valid: true},
+		{value: "10.0.0.1", valid: true},
+		{value: "10.0.0", valid: false},
+		{value: "2001:db8::1", valid: false},
+		{value: "router1", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes["router_id"], tt.value))
+		})
+	}
+}
+
+func TestOSPF6SettingsDataSourceSchema(t *testing.T) {
+	schema := ospf6SettingsDataSourceSchema()
+
+	for _, field := range []string{"enabled", "router_id", "passive_default", "redistribute", "redistribute_route_map", "default_originate", "default_originate_always", "default_originate_metric"} {
+		attr := schema.Attributes[field]
+		assert.NotNil(t, attr, "Field %s should exist", field)
+		assert.True(t, attr.IsComputed(), "Field %s should be computed", field)
+	}
+}
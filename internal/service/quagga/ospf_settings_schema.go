@@ -0,0 +1,173 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ospfSettingsResourceModel describes the resource data model.
+type ospfSettingsResourceModel struct {
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	RouterID               types.String `tfsdk:"router_id"`
+	PassiveDefault         types.Bool   `tfsdk:"passive_default"`
+	Redistribute           types.Set    `tfsdk:"redistribute"`
+	RedistributeRouteMap   types.String `tfsdk:"redistribute_route_map"`
+	DefaultOriginate       types.Bool   `tfsdk:"default_originate"`
+	DefaultOriginateAlways types.Bool   `tfsdk:"default_originate_always"`
+	DefaultOriginateMetric types.Int64  `tfsdk:"default_originate_metric"`
+}
+
+func ospfSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the global settings for OSPF. This is a singleton, only one instance of this resource should exist. Destroying it disables OSPF.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable OSPF. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"router_id": schema.StringAttribute{
+				MarkdownDescription: "The router ID in dotted decimal notation, e.g. `10.0.0.1`. Leave empty to let FRR choose. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						stringvalidator.RegexMatches(ospfAreaIDRegex, "must be in dotted decimal notation"),
+					),
+				},
+			},
+			"passive_default": schema.BoolAttribute{
+				MarkdownDescription: "Make all interfaces passive by default. Interfaces which should form adjacencies must then set `passive = false` in `opnsense_quagga_ospf_interface`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"redistribute": schema.SetAttribute{
+				MarkdownDescription: "Route sources to redistribute into OSPF. Available values: `connected`, `kernel`, `static`, `bgp`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("connected", "kernel", "static", "bgp"),
+					),
+				},
+			},
+			"redistribute_route_map": schema.StringAttribute{
+				MarkdownDescription: "The route map ID used to filter redistributed routes, e.g. `opnsense_quagga_bgp_routemap.example.id`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"default_originate": schema.BoolAttribute{
+				MarkdownDescription: "Advertise a default route into OSPF if one is present in the routing table. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_originate_always": schema.BoolAttribute{
+				MarkdownDescription: "Always advertise a default route, even if none is present in the routing table. Requires `default_originate`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_originate_metric": schema.Int64Attribute{
+				MarkdownDescription: "Metric of the advertised default route. Set to `-1` to use the FRR default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(0, 16777214),
+					),
+				},
+			},
+		},
+	}
+}
+
+func ospfSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the global settings for OSPF.",
+
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether OSPF is enabled.",
+				Computed:            true,
+			},
+			"router_id": dschema.StringAttribute{
+				MarkdownDescription: "The router ID in dotted decimal notation.",
+				Computed:            true,
+			},
+			"passive_default": dschema.BoolAttribute{
+				MarkdownDescription: "Whether all interfaces are passive by default.",
+				Computed:            true,
+			},
+			"redistribute": dschema.SetAttribute{
+				MarkdownDescription: "Route sources redistributed into OSPF.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redistribute_route_map": dschema.StringAttribute{
+				MarkdownDescription: "The route map ID used to filter redistributed routes.",
+				Computed:            true,
+			},
+			"default_originate": dschema.BoolAttribute{
+				MarkdownDescription: "Whether a default route is advertised into OSPF.",
+				Computed:            true,
+			},
+			"default_originate_always": dschema.BoolAttribute{
+				MarkdownDescription: "Whether a default route is always advertised.",
+				Computed:            true,
+			},
+			"default_originate_metric": dschema.Int64Attribute{
+				MarkdownDescription: "Metric of the advertised default route.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertOSPFSettingsSchemaToStruct(d *ospfSettingsResourceModel) (*quagga.OSPFSettings, error) {
+	return &quagga.OSPFSettings{
+		Enabled:                tools.BoolToString(d.Enabled.ValueBool()),
+		RouterID:               d.RouterID.ValueString(),
+		PassiveDefault:         tools.BoolToString(d.PassiveDefault.ValueBool()),
+		Redistribute:           api.SelectedMapList(tools.SetToStringSlice(d.Redistribute)),
+		RedistributeRouteMap:   api.SelectedMap(d.RedistributeRouteMap.ValueString()),
+		DefaultOriginate:       tools.BoolToString(d.DefaultOriginate.ValueBool()),
+		DefaultOriginateAlways: tools.BoolToString(d.DefaultOriginateAlways.ValueBool()),
+		DefaultOriginateMetric: tools.Int64ToStringNegative(d.DefaultOriginateMetric.ValueInt64()),
+	}, nil
+}
+
+func convertOSPFSettingsStructToSchema(d *quagga.OSPFSettings) (*ospfSettingsResourceModel, error) {
+	return &ospfSettingsResourceModel{
+		Enabled:                types.BoolValue(tools.StringToBool(d.Enabled)),
+		RouterID:               types.StringValue(d.RouterID),
+		PassiveDefault:         types.BoolValue(tools.StringToBool(d.PassiveDefault)),
+		Redistribute:           tools.StringSliceToSet(d.Redistribute),
+		RedistributeRouteMap:   types.StringValue(d.RedistributeRouteMap.String()),
+		DefaultOriginate:       types.BoolValue(tools.StringToBool(d.DefaultOriginate)),
+		DefaultOriginateAlways: types.BoolValue(tools.StringToBool(d.DefaultOriginateAlways)),
+		DefaultOriginateMetric: types.Int64Value(tools.StringToInt64(d.DefaultOriginateMetric)),
+	}, nil
+}
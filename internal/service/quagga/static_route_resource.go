@@ -0,0 +1,224 @@
+package quagga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &staticRouteResource{}
+var _ resource.ResourceWithConfigure = &staticRouteResource{}
+var _ resource.ResourceWithImportState = &staticRouteResource{}
+var _ resource.ResourceWithValidateConfig = &staticRouteResource{}
+
+func newStaticRouteResource() resource.Resource {
+	return &staticRouteResource{}
+}
+
+// staticRouteResource defines the resource implementation.
+type staticRouteResource struct {
+	client opnsense.Client
+}
+
+func (r *staticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_static_route"
+}
+
+func (r *staticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = staticRouteResourceSchema()
+}
+
+func (r *staticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *staticRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *staticRouteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.Network.IsUnknown() || data.Gateway.IsUnknown() || data.Interface.IsUnknown() {
+		return
+	}
+
+	hasGateway := !data.Gateway.IsNull() && data.Gateway.ValueString() != ""
+	hasInterface := !data.Interface.IsNull() && data.Interface.ValueString() != ""
+	if !hasGateway && !hasInterface {
+		resp.Diagnostics.AddAttributeError(path.Root("gateway"), "Missing Attribute Configuration",
+			"Either gateway or interface must be set for a static route.")
+		return
+	}
+
+	if !hasGateway {
+		return
+	}
+
+	// The next hop must be reachable over the same address family as the destination
+	_, network, err := net.ParseCIDR(data.Network.ValueString())
+	gateway := net.ParseIP(data.Gateway.ValueString())
+	if err != nil || gateway == nil {
+		return
+	}
+	if (network.IP.To4() == nil) != (gateway.To4() == nil) {
+		resp.Diagnostics.AddAttributeError(path.Root("gateway"), "Invalid Attribute Combination",
+			fmt.Sprintf("Gateway %s is not of the same address family as network %s.", data.Gateway.ValueString(), data.Network.ValueString()))
+	}
+}
+
+func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *staticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	staticRoute, err := convertStaticRouteSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse static route, got error: %s", err))
+		return
+	}
+
+	// Add static route to OPNsense quagga
+	id, err := r.client.Quagga().AddStaticRoute(ctx, staticRoute)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create static route, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *staticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get static route from OPNsense quagga API
+	staticRoute, err := r.client.Quagga().GetStaticRoute(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("static route not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read static route, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	staticRouteModel, err := convertStaticRouteStructToSchema(staticRoute)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read static route, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	staticRouteModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &staticRouteModel)...)
+}
+
+func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *staticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	staticRoute, err := convertStaticRouteSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse static route, got error: %s", err))
+		return
+	}
+
+	// Update static route in OPNsense quagga
+	err = r.client.Quagga().UpdateStaticRoute(ctx, data.Id.ValueString(), staticRoute)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update static route, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *staticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Quagga().DeleteStaticRoute(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete static route, got error: %s", err))
+		return
+	}
+}
+
+func (r *staticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,155 @@
+package quagga
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// staticRouteResourceModel describes the resource data model.
+type staticRouteResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Network     types.String `tfsdk:"network"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Interface   types.String `tfsdk:"interface"`
+	Distance    types.Int64  `tfsdk:"distance"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func staticRouteResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure static routes managed by FRR. Unlike `opnsense_route`, these routes can be redistributed into BGP and OSPF.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this static route. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this static route. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "The destination network in CIDR notation (e.g. `10.0.0.0/24`).",
+				Required:            true,
+				Validators: []validator.String{
+					validators.CIDR(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "The next-hop IP address. Must be of the same address family as `network`. At least one of `gateway` or `interface` must be set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						validators.IP(),
+					),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface to send traffic out of. Must be a valid OPNsense interface in lowercase (e.g. `wan`). At least one of `gateway` or `interface` must be set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"distance": schema.Int64Attribute{
+				MarkdownDescription: "The administrative distance of this route. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 255),
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the static route.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func staticRouteDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure static routes managed by FRR.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Enable this static route.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this static route.",
+				Computed:            true,
+			},
+			"network": dschema.StringAttribute{
+				MarkdownDescription: "The destination network in CIDR notation.",
+				Computed:            true,
+			},
+			"gateway": dschema.StringAttribute{
+				MarkdownDescription: "The next-hop IP address.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface to send traffic out of.",
+				Computed:            true,
+			},
+			"distance": dschema.Int64Attribute{
+				MarkdownDescription: "The administrative distance of this route, or `-1` if the default is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertStaticRouteSchemaToStruct(d *staticRouteResourceModel) (*quagga.StaticRoute, error) {
+	return &quagga.StaticRoute{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Description: d.Description.ValueString(),
+		Network:     d.Network.ValueString(),
+		Gateway:     d.Gateway.ValueString(),
+		Interface:   api.SelectedMap(d.Interface.ValueString()),
+		Distance:    tools.Int64ToStringNegative(d.Distance.ValueInt64()),
+	}, nil
+}
+
+func convertStaticRouteStructToSchema(d *quagga.StaticRoute) (*staticRouteResourceModel, error) {
+	return &staticRouteResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description: types.StringValue(d.Description),
+		Network:     types.StringValue(d.Network),
+		Gateway:     types.StringValue(d.Gateway),
+		Interface:   types.StringValue(d.Interface.String()),
+		Distance:    types.Int64Value(tools.StringToInt64(d.Distance)),
+	}, nil
+}
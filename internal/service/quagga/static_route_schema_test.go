@@ -0,0 +1,127 @@
+package quagga
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertStaticRouteSchemaToStruct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *staticRouteResourceModel
+		expected *quagga.StaticRoute
+	}{
+		{
+			name: "gateway",
+			input: &staticRouteResourceModel{
+				Enabled:     types.BoolValue(true),
+				Description: types.StringValue("default route"),
+				Network:     types.StringValue("0.0.0.0/0"),
+				Gateway:     types.StringValue("192.0.2.1"),
+				Interface:   types.StringValue(""),
+				Distance:    types.Int64Value(-1),
+				Id:          types.StringValue("route-id"),
+			},
+			expected: &quagga.StaticRoute{
+				Enabled:     "1",
+				Description: "default route",
+				Network:     "0.0.0.0/0",
+				Gateway:     "192.0.2.1",
+				Interface:   api.SelectedMap(""),
+				Distance:    "",
+			},
+		},
+		{
+			name: "interface_with_distance",
+			input: &staticRouteResourceModel{
+				Enabled:     types.BoolValue(false),
+				Description: types.StringValue(""),
+				Network:     types.StringValue("2001:db8::/32"),
+				Gateway:     types.StringValue(""),
+				Interface:   types.StringValue("lan"),
+				Distance:    types.Int64Value(200),
+			},
+			expected: &quagga.StaticRoute{
+				Enabled:     "0",
+				Description: "",
+				Network:     "2001:db8::/32",
+				Gateway:     "",
+				Interface:   api.SelectedMap("lan"),
+				Distance:    "200",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertStaticRouteSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestConvertStaticRouteStructToSchema(t *testing.T) {
+	result, err := convertStaticRouteStructToSchema(&quagga.StaticRoute{
+		Enabled:     "1",
+		Description: "",
+		Network:     "198.51.100.0/24",
+		Gateway:     "192.0.2.1",
+		Interface:   api.SelectedMap(""),
+		Distance:    "",
+	})
+	assert.NoError(t, err)
+
+	// An empty distance is read back as -1, the same as an unset distance
+	assert.Equal(t, types.Int64Value(-1), result.Distance)
+	assert.Equal(t, types.StringValue("198.51.100.0/24"), result.Network)
+	assert.Equal(t, types.StringValue(""), result.Interface)
+}
+
+func TestConvertStaticRouteRoundTrip(t *testing.T) {
+	original := &staticRouteResourceModel{
+		Enabled:     types.BoolValue(true),
+		Description: types.StringValue("branch office"),
+		Network:     types.StringValue("10.20.0.0/16"),
+		Gateway:     types.StringValue("192.0.2.254"),
+		Interface:   types.StringValue("opt1"),
+		Distance:    types.Int64Value(10),
+	}
+
+	route, err := convertStaticRouteSchemaToStruct(original)
+	assert.NoError(t, err)
+
+	result, err := convertStaticRouteStructToSchema(route)
+	assert.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestStaticRouteSchemaValidation(t *testing.T) {
+	schema := staticRouteResourceSchema()
+
+	assert.True(t, schema.Attributes["network"].IsRequired())
+	assert.True(t, schema.Attributes["id"].IsComputed())
+
+	tests := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{attribute: "network", value: "10.0.0.0/8", valid: true},
+		{attribute: "network", value: "2001:db8::/32", valid: true},
+		{attribute: "network", value: "10.0.0.1", valid: false},
+		{attribute: "gateway", value: "", valid: true},
+		{attribute: "gateway", value: "192.0.2.1", valid: true},
+		{attribute: "gateway", value: "gateway.example.com", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"_"+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateStringAttribute(t, schema.Attributes[tt.attribute], tt.value))
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+)
+
+// routeKernelCheckAttempts bounds how long we wait for a route to appear in the kernel table after apply.
+const routeKernelCheckAttempts = 10
+
+// normalizeNetwork parses a network (CIDR or bare address) and returns its canonical CIDR form and address family.
+func normalizeNetwork(network string) (string, string, error) {
+	if !strings.Contains(network, "/") {
+		ip := net.ParseIP(network)
+		if ip == nil {
+			return "", "", fmt.Errorf("%q is not a valid network or address", network)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", "inet", nil
+		}
+		return ip.String() + "/128", "inet6", nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(network)
+	if err != nil {
+		return "", "", fmt.Errorf("%q is not a valid network: %w", network, err)
+	}
+	if ip.To4() != nil {
+		return ipNet.String(), "inet", nil
+	}
+	return ipNet.String(), "inet6", nil
+}
+
+// normalizeIpProtocol maps the different spellings OPNsense uses for a gateway address family to `inet`/`inet6`.
+func normalizeIpProtocol(s string) string {
+	switch strings.ToLower(s) {
+	case "inet", "ipv4":
+		return "inet"
+	case "inet6", "ipv6":
+		return "inet6"
+	}
+	return ""
+}
+
+// routeGateway describes the parts of a configured gateway that routes depend on.
+type routeGateway struct {
+	protocol string
+	address  string
+}
+
+// lookupGateway returns the named gateway, and false if no such gateway exists.
+func lookupGateway(ctx context.Context, client opnsense.Client, name string) (routeGateway, bool, error) {
+	res, err := client.Gateway().SettingsSearchGateway(ctx)
+	if err != nil {
+		return routeGateway{}, false, err
+	}
+
+	for _, row := range res.Rows {
+		if row.Name == name {
+			return routeGateway{
+				protocol: normalizeIpProtocol(row.IPProtocol),
+				address:  row.Gateway,
+			}, true, nil
+		}
+	}
+	return routeGateway{}, false, nil
+}
+
+// configuredRoute is a route as stored in the OPNsense configuration.
+type configuredRoute struct {
+	id       string
+	network  string
+	disabled bool
+}
+
+// searchConfiguredRoutes returns all routes stored in the OPNsense configuration.
+func searchConfiguredRoutes(ctx context.Context, client opnsense.Client) ([]configuredRoute, error) {
+	routeList, err := client.Routes().SearchRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	configured := make([]configuredRoute, 0, len(routeList))
+	for _, row := range routeList {
+		configured = append(configured, configuredRoute{
+			id:       row.UUID,
+			network:  row.Network,
+			disabled: tools.StringToBool(row.Disabled),
+		})
+	}
+	return configured, nil
+}
+
+// findConflictingRoute returns the UUID of another enabled route for the same network, or "" if there is none.
+// The network must be in the canonical form returned by normalizeNetwork.
+func findConflictingRoute(configured []configuredRoute, id string, network string) string {
+	for _, route := range configured {
+		if route.id == id || route.disabled {
+			continue
+		}
+
+		other, _, err := normalizeNetwork(route.network)
+		if err != nil {
+			continue
+		}
+		if other == network {
+			return route.id
+		}
+	}
+	return ""
+}
+
+// sameAddress reports whether two addresses are equal, ignoring any `%zone` suffix of link-local addresses.
+func sameAddress(a string, b string) bool {
+	a, _, _ = strings.Cut(a, "%")
+	b, _, _ = strings.Cut(b, "%")
+
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	return ipA != nil && ipB != nil && ipA.Equal(ipB)
+}
+
+// kernelRouteMatches reports whether a kernel route is the route to network via gatewayAddress. When the gateway
+// address is not a fixed IP (e.g. `dynamic`), only the destination is compared.
+func kernelRouteMatches(destination string, gateway string, network string, gatewayAddress string) bool {
+	got, _, err := normalizeNetwork(destination)
+	if err != nil || got != network {
+		return false
+	}
+
+	if net.ParseIP(gatewayAddress) == nil {
+		return true
+	}
+	return sameAddress(gateway, gatewayAddress)
+}
+
+// verifyRouteInstalled checks that the gateway is known and up, then waits for the route to show up in the kernel
+// routing table. Without this, a route pointing at a missing or offline gateway is silently never installed.
+// A gateway that is down is not an error, since routes via backup gateways are valid, so it is returned as a
+// warning instead.
+func verifyRouteInstalled(ctx context.Context, client opnsense.Client, gatewayName string, network string) (string, error) {
+	gateway, found, err := lookupGateway(ctx, client, gatewayName)
+	if err != nil {
+		return "", fmt.Errorf("unable to read gateways: %w", err)
+	}
+	if !found {
+		return "", fmt.Errorf("gateway %q does not exist, the route to %s was not installed", gatewayName, network)
+	}
+
+	status, err := client.Gateway().GatewayStatus(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to read gateway status: %w", err)
+	}
+
+	for _, gw := range status.Items {
+		if gw.Name == gatewayName && (gw.Status == "down" || gw.Status == "force_down") {
+			return fmt.Sprintf("Gateway %q is %s, so the route to %s is not installed until it comes back up.", gatewayName, gw.StatusTranslated, network), nil
+		}
+	}
+
+	want, _, err := normalizeNetwork(network)
+	if err != nil {
+		return "", err
+	}
+
+	for range routeKernelCheckAttempts {
+		kernelRoutes, err := client.Diagnostics().GetRoutes(ctx)
+		if err != nil {
+			return "", fmt.Errorf("unable to read kernel routing table: %w", err)
+		}
+
+		for _, route := range kernelRoutes {
+			if kernelRouteMatches(route.Destination, route.Gateway, want, gateway.address) {
+				return "", nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+
+	return "", fmt.Errorf("route to %s via gateway %q is not present in the kernel routing table", network, gatewayName)
+}
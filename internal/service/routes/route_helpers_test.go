@@ -0,0 +1,98 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		network string
+		family  string
+	}{
+		{name: "ipv4_cidr", input: "10.0.0.0/24", network: "10.0.0.0/24", family: "inet"},
+		{name: "ipv4_host_bits", input: "10.0.0.1/24", network: "10.0.0.0/24", family: "inet"},
+		{name: "ipv4_address", input: "192.0.2.1", network: "192.0.2.1/32", family: "inet"},
+		{name: "ipv6_cidr", input: "2001:db8::/32", network: "2001:db8::/32", family: "inet6"},
+		{name: "ipv6_uncompressed", input: "2001:0db8:0000::/48", network: "2001:db8::/48", family: "inet6"},
+		{name: "ipv6_address", input: "2001:db8::1", network: "2001:db8::1/128", family: "inet6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			network, family, err := normalizeNetwork(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.network, network)
+			assert.Equal(t, tt.family, family)
+		})
+	}
+}
+
+func TestNormalizeNetworkInvalid(t *testing.T) {
+	for _, input := range []string{"", "default", "10.0.0.0/33", "10.0.0.300", "2001:db8::/129"} {
+		t.Run(input, func(t *testing.T) {
+			_, _, err := normalizeNetwork(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFindConflictingRoute(t *testing.T) {
+	configured := []configuredRoute{
+		{id: "a", network: "10.0.0.0/24"},
+		{id: "b", network: "10.1.0.1/24"},
+		{id: "c", network: "10.2.0.0/24", disabled: true},
+		{id: "d", network: "2001:db8::/32"},
+		{id: "e", network: "not a network"},
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		network  string
+		conflict string
+	}{
+		{name: "no_conflict", id: "", network: "10.9.0.0/24", conflict: ""},
+		{name: "same_network", id: "", network: "10.0.0.0/24", conflict: "a"},
+		{name: "normalized_network", id: "", network: "10.1.0.0/24", conflict: "b"},
+		{name: "itself", id: "a", network: "10.0.0.0/24", conflict: ""},
+		{name: "disabled_route", id: "", network: "10.2.0.0/24", conflict: ""},
+		{name: "ipv6", id: "", network: "2001:db8::/32", conflict: "d"},
+		{name: "different_length", id: "", network: "10.0.0.0/16", conflict: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.conflict, findConflictingRoute(configured, tt.id, tt.network))
+		})
+	}
+}
+
+func TestKernelRouteMatches(t *testing.T) {
+	tests := []struct {
+		name           string
+		destination    string
+		gateway        string
+		network        string
+		gatewayAddress string
+		matches        bool
+	}{
+		{name: "same_gateway", destination: "10.0.0.0/24", gateway: "192.0.2.1", network: "10.0.0.0/24", gatewayAddress: "192.0.2.1", matches: true},
+		{name: "other_gateway", destination: "10.0.0.0/24", gateway: "192.0.2.2", network: "10.0.0.0/24", gatewayAddress: "192.0.2.1", matches: false},
+		{name: "other_destination", destination: "10.1.0.0/24", gateway: "192.0.2.1", network: "10.0.0.0/24", gatewayAddress: "192.0.2.1", matches: false},
+		{name: "dynamic_gateway", destination: "10.0.0.0/24", gateway: "link#1", network: "10.0.0.0/24", gatewayAddress: "dynamic", matches: true},
+		{name: "link_local_zone", destination: "2001:db8::/32", gateway: "fe80::1%vtnet0", network: "2001:db8::/32", gatewayAddress: "fe80::1", matches: true},
+		{name: "host_route", destination: "192.0.2.10", gateway: "192.0.2.1", network: "192.0.2.10/32", gatewayAddress: "192.0.2.1", matches: true},
+		{name: "default_destination", destination: "default", gateway: "192.0.2.1", network: "10.0.0.0/24", gatewayAddress: "192.0.2.1", matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.matches, kernelRouteMatches(tt.destination, tt.gateway, tt.network, tt.gatewayAddress))
+		})
+	}
+}
@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +18,7 @@ import (
 var _ resource.Resource = &routeResource{}
 var _ resource.ResourceWithConfigure = &routeResource{}
 var _ resource.ResourceWithImportState = &routeResource{}
+var _ resource.ResourceWithModifyPlan = &routeResource{}
 
 func newRouteResource() resource.Resource {
 	return &routeResource{}
@@ -54,6 +55,76 @@ func (r *routeResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *routeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or if the provider has not been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data *routeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Network.IsUnknown() || data.Gateway.IsUnknown() {
+		return
+	}
+
+	// Only check routes whose network, gateway or enabled state change, so unrelated plans make no API calls.
+	if !req.State.Raw.IsNull() {
+		var state *routeResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Network.Equal(data.Network) && state.Gateway.Equal(data.Gateway) && state.Enabled.Equal(data.Enabled) {
+			return
+		}
+	}
+
+	network, family, err := normalizeNetwork(data.Network.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("network"), "Invalid Network", err.Error())
+		return
+	}
+
+	// The gateway may be created in the same apply, so only check the address family if it already exists.
+	gateway, found, err := lookupGateway(ctx, r.client, data.Gateway.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read gateways, got error: %s", err))
+		return
+	}
+	if found && gateway.protocol != "" && gateway.protocol != family {
+		resp.Diagnostics.AddAttributeError(path.Root("network"), "Address Family Mismatch",
+			fmt.Sprintf("Network %s is %s, but gateway %q uses ip_protocol %s.",
+				data.Network.ValueString(), family, data.Gateway.ValueString(), gateway.protocol))
+		return
+	}
+
+	if !data.Enabled.ValueBool() {
+		return
+	}
+
+	configured, err := searchConfiguredRoutes(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	// The other route may be changed in the same apply (e.g. when two routes swap networks), which is not visible
+	// here, so this is only a warning.
+	if conflict := findConflictingRoute(configured, data.Id.ValueString(), network); conflict != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("network"), "Conflicting Route",
+			fmt.Sprintf("Network %s is currently routed by route %s. Unless that route is changed in the same apply, only one of them will be used.", network, conflict))
+	}
+}
+
 func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *routeResourceModel
 
@@ -83,6 +154,20 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Tag new resource with ID from OPNsense
 	data.Id = types.StringValue(id)
 
+	// Confirm the route was actually installed
+	if data.Enabled.ValueBool() {
+		warning, err := verifyRouteInstalled(ctx, r.client, data.Gateway.ValueString(), data.Network.ValueString())
+		if warning != "" {
+			resp.Diagnostics.AddWarning("Gateway Down", warning)
+		}
+		if err != nil {
+			// Keep the route in state so it can be fixed or destroyed
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Route Not Installed", err.Error())
+			return
+		}
+	}
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a resource")
 
@@ -156,6 +241,19 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Confirm the route was actually installed
+	if data.Enabled.ValueBool() {
+		warning, err := verifyRouteInstalled(ctx, r.client, data.Gateway.ValueString(), data.Network.ValueString())
+		if warning != "" {
+			resp.Diagnostics.AddWarning("Gateway Down", warning)
+		}
+		if err != nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Route Not Installed", err.Error())
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
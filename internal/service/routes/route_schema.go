@@ -1,8 +1,8 @@
 package routes
 
 import (
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/routes"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/routes"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,11 +38,11 @@ func routeResourceSchema() schema.Schema {
 				Optional:            true,
 			},
 			"gateway": schema.StringAttribute{
-				MarkdownDescription: "Which gateway this route applies, e.g. `WAN`. Must be an existing gateway.",
+				MarkdownDescription: "Which gateway this route applies, e.g. `WAN`. Must be an existing gateway. After apply, the provider verifies the route is present in the kernel routing table, routed via this gateway. If the gateway is down, a warning is shown instead.",
 				Required:            true,
 			},
 			"network": schema.StringAttribute{
-				MarkdownDescription: "Destination network for this static route.",
+				MarkdownDescription: "Destination network for this static route, e.g. `10.0.0.0/24`. Must match the address family (`ip_protocol`) of the gateway. A warning is shown if another enabled route already routes this network.",
 				Required:            true,
 			},
 			"id": schema.StringAttribute{
@@ -0,0 +1,89 @@
+package unbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &aclDataSource{}
+var _ datasource.DataSourceWithConfigure = &aclDataSource{}
+
+func newACLDataSource() datasource.DataSource {
+	return &aclDataSource{}
+}
+
+// aclDataSource defines the data source implementation.
+type aclDataSource struct {
+	client opnsense.Client
+}
+
+func (d *aclDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_acl"
+}
+
+func (d *aclDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = aclDataSourceSchema()
+}
+
+func (d *aclDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *aclDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get default action from OPNsense unbound API
+	defaultAction, err := d.client.Unbound().GetACLDefaultAction(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+		return
+	}
+
+	// Get all ACLs from OPNsense unbound API
+	rows, err := d.client.Unbound().SearchACLs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense structs to TF schema
+	entries := make([]aclEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, convertACLSearchRowToEntry(row))
+	}
+
+	acls, err := aclEntriesToList(ctx, entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+		return
+	}
+
+	model := aclResourceModel{
+		DefaultAction: types.StringValue(defaultAction),
+		ACLs:          acls,
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,337 @@
+package unbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &aclResource{}
+var _ resource.ResourceWithConfigure = &aclResource{}
+var _ resource.ResourceWithValidateConfig = &aclResource{}
+
+func newACLResource() resource.Resource {
+	return &aclResource{}
+}
+
+// aclResource defines the resource implementation.
+type aclResource struct {
+	client opnsense.Client
+}
+
+func (r *aclResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_acl"
+}
+
+func (r *aclResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = aclResourceSchema()
+}
+
+func (r *aclResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *aclResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *aclResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ACLs.IsNull() || data.ACLs.IsUnknown() {
+		return
+	}
+
+	entries, err := aclEntriesFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	for _, problem := range validateACLEntries(entries) {
+		resp.Diagnostics.AddError("Invalid ACL", problem)
+	}
+}
+
+// reconcile adds, updates and removes ACLs so that OPNsense matches the plan. ACLs
+// are matched against the prior state by name, and ACLs that did not change are left
+// untouched. The returned list contains every ACL known to exist in OPNsense, even
+// when an error occurs part way through.
+func (r *aclResource) reconcile(ctx context.Context, plan *aclResourceModel, prior *aclResourceModel) (types.List, error) {
+	planned, err := aclEntriesFromModel(ctx, plan)
+	if err != nil {
+		return types.ListNull(types.ObjectType{AttrTypes: aclEntryAttrTypes}), err
+	}
+
+	existing, err := aclEntriesFromModel(ctx, prior)
+	if err != nil {
+		return types.ListNull(types.ObjectType{AttrTypes: aclEntryAttrTypes}), err
+	}
+
+	// Track which ACLs exist in OPNsense, keyed by name
+	applied := map[string]aclEntry{}
+	for _, e := range existing {
+		applied[e.Name.ValueString()] = e
+	}
+
+	err = r.reconcileEntries(ctx, planned, applied)
+
+	// Build the resulting ACLs in plan order, followed by any ACLs that could not be removed
+	var result []aclEntry
+	for _, e := range planned {
+		if a, ok := applied[e.Name.ValueString()]; ok {
+			result = append(result, a)
+			delete(applied, e.Name.ValueString())
+		}
+	}
+	for _, e := range existing {
+		if a, ok := applied[e.Name.ValueString()]; ok {
+			result = append(result, a)
+		}
+	}
+
+	list, convErr := aclEntriesToList(ctx, result)
+	if convErr != nil {
+		return list, convErr
+	}
+
+	return list, err
+}
+
+func (r *aclResource) reconcileEntries(ctx context.Context, planned []aclEntry, applied map[string]aclEntry) error {
+	wanted := map[string]bool{}
+	for _, e := range planned {
+		wanted[e.Name.ValueString()] = true
+	}
+
+	// Remove ACLs that are no longer planned
+	for name, e := range applied {
+		if wanted[name] {
+			continue
+		}
+
+		err := r.client.Unbound().DeleteACL(ctx, e.Id.ValueString())
+		if err != nil {
+			var notFoundError *errs.NotFoundError
+			if !errors.As(err, &notFoundError) {
+				return fmt.Errorf("unable to delete acl %q: %w", name, err)
+			}
+		}
+
+		delete(applied, name)
+		tflog.Debug(ctx, "removed acl", map[string]any{"name": name})
+	}
+
+	for _, e := range planned {
+		name := e.Name.ValueString()
+		acl := convertACLEntryToStruct(&e)
+
+		if current, ok := applied[name]; ok {
+			// Leave unchanged ACLs alone
+			if reflect.DeepEqual(convertACLEntryToStruct(&current), acl) {
+				continue
+			}
+
+			err := r.client.Unbound().UpdateACL(ctx, current.Id.ValueString(), acl)
+			if err != nil {
+				return fmt.Errorf("unable to update acl %q: %w", name, err)
+			}
+
+			e.Id = current.Id
+			applied[name] = e
+			tflog.Debug(ctx, "updated acl", map[string]any{"name": name})
+			continue
+		}
+
+		id, err := r.client.Unbound().AddACL(ctx, acl)
+		if err != nil {
+			return fmt.Errorf("unable to add acl %q: %w", name, err)
+		}
+
+		e.Id = types.StringValue(id)
+		applied[name] = e
+		tflog.Debug(ctx, "added acl", map[string]any{"name": name})
+	}
+
+	return nil
+}
+
+func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *aclResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add all ACLs to unbound
+	acls, err := r.reconcile(ctx, data, nil)
+	data.ACLs = acls
+
+	if err == nil {
+		err = r.client.Unbound().UpdateACLDefaultAction(ctx, data.DefaultAction.ValueString())
+	}
+
+	// Save the ACLs that were created, so they are cleaned up on destroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create unbound acls, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+}
+
+func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *aclResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultAction, err := r.client.Unbound().GetACLDefaultAction(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+		return
+	}
+	data.DefaultAction = types.StringValue(defaultAction)
+
+	existing, err := aclEntriesFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+		return
+	}
+
+	// Get each ACL from OPNsense unbound API
+	var entries []aclEntry
+	for _, e := range existing {
+		acl, err := r.client.Unbound().GetACL(ctx, e.Id.ValueString())
+		if err != nil {
+			var notFoundError *errs.NotFoundError
+			if errors.As(err, &notFoundError) {
+				tflog.Warn(ctx, fmt.Sprintf("acl %q not present in remote, removing from state", e.Name.ValueString()))
+				continue
+			}
+
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+			return
+		}
+
+		entries = append(entries, convertACLStructToEntry(e.Id.ValueString(), acl))
+	}
+
+	data.ACLs, err = aclEntriesToList(ctx, entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound acls, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *aclResourceModel
+	var state *aclResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply only the ACLs that differ from the prior state
+	acls, err := r.reconcile(ctx, data, state)
+	data.ACLs = acls
+
+	if err == nil && !data.DefaultAction.Equal(state.DefaultAction) {
+		err = r.client.Unbound().UpdateACLDefaultAction(ctx, data.DefaultAction.ValueString())
+		if err != nil {
+			data.DefaultAction = state.DefaultAction
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update unbound acls, got error: %s", err))
+		return
+	}
+}
+
+func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *aclResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := aclEntriesFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete unbound acls, got error: %s", err))
+		return
+	}
+
+	for _, e := range entries {
+		err := r.client.Unbound().DeleteACL(ctx, e.Id.ValueString())
+		if err != nil {
+			var notFoundError *errs.NotFoundError
+			if errors.As(err, &notFoundError) {
+				continue
+			}
+
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete unbound acls, got error: %s", err))
+			return
+		}
+	}
+
+	// Restore the OPNsense default, so clients are not locked out
+	err = r.client.Unbound().UpdateACLDefaultAction(ctx, "allow")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to reset unbound acl default action, got error: %s", err))
+		return
+	}
+}
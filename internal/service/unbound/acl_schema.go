@@ -0,0 +1,245 @@
+package unbound
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aclActions are the actions Unbound supports for an access control list.
+var aclActions = []string{"allow", "deny", "refuse", "allow_snoop", "deny_non_local", "refuse_non_local"}
+
+// aclResourceModel describes the resource data model.
+type aclResourceModel struct {
+	DefaultAction types.String `tfsdk:"default_action"`
+	ACLs          types.List   `tfsdk:"acls"`
+}
+
+// aclEntry describes a single named access control list.
+type aclEntry struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Name        types.String `tfsdk:"name"`
+	Action      types.String `tfsdk:"action"`
+	Networks    types.Set    `tfsdk:"networks"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var aclEntryAttrTypes = map[string]attr.Type{
+	"enabled":     types.BoolType,
+	"name":        types.StringType,
+	"action":      types.StringType,
+	"networks":    types.SetType{ElemType: types.StringType},
+	"description": types.StringType,
+	"id":          types.StringType,
+}
+
+func aclResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the access control lists of Unbound, which decide which clients may query the resolver. Only the listed ACLs are managed, and only ACLs that differ are added, changed or removed on apply. Only one instance of this resource should be used.",
+
+		Attributes: map[string]schema.Attribute{
+			"default_action": schema.StringAttribute{
+				MarkdownDescription: "The action for clients that do not match any ACL. One of `allow`, `deny`, `refuse`, `allow_snoop`, `deny_non_local`, `refuse_non_local`. Defaults to `\"allow\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("allow"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(aclActions...),
+				},
+			},
+			"acls": schema.ListNestedAttribute{
+				MarkdownDescription: "The named access control lists. Names must be unique. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: aclEntryAttrTypes}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Enable this access control list. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of this access control list.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The action for clients in `networks`. One of `allow`, `deny`, `refuse`, `allow_snoop`, `deny_non_local`, `refuse_non_local`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(aclActions...),
+							},
+						},
+						"networks": schema.SetAttribute{
+							MarkdownDescription: "The client networks this access control list applies to, in CIDR notation (e.g. `10.10.0.0/24`).",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(validators.CIDR()),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "An optional description for this access control list. Defaults to `\"\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the access control list.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func aclDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Use this data source to read the access control lists of Unbound.",
+
+		Attributes: map[string]dschema.Attribute{
+			"default_action": dschema.StringAttribute{
+				MarkdownDescription: "The action for clients that do not match any ACL.",
+				Computed:            true,
+			},
+			"acls": dschema.ListNestedAttribute{
+				MarkdownDescription: "All access control lists configured in OPNsense.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"enabled": dschema.BoolAttribute{
+							MarkdownDescription: "Whether this access control list is enabled.",
+							Computed:            true,
+						},
+						"name": dschema.StringAttribute{
+							MarkdownDescription: "The name of this access control list.",
+							Computed:            true,
+						},
+						"action": dschema.StringAttribute{
+							MarkdownDescription: "The action for clients in `networks`.",
+							Computed:            true,
+						},
+						"networks": dschema.SetAttribute{
+							MarkdownDescription: "The client networks this access control list applies to.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"description": dschema.StringAttribute{
+							MarkdownDescription: "An optional description for this access control list.",
+							Computed:            true,
+						},
+						"id": dschema.StringAttribute{
+							MarkdownDescription: "UUID of the access control list.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func aclEntriesFromModel(ctx context.Context, data *aclResourceModel) ([]aclEntry, error) {
+	var entries []aclEntry
+	if data == nil || data.ACLs.IsNull() || data.ACLs.IsUnknown() {
+		return entries, nil
+	}
+
+	if diags := data.ACLs.ElementsAs(ctx, &entries, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to parse acls: %v", diags)
+	}
+	return entries, nil
+}
+
+func aclEntriesToList(ctx context.Context, entries []aclEntry) (types.List, error) {
+	if entries == nil {
+		entries = []aclEntry{}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: aclEntryAttrTypes}, entries)
+	if diags.HasError() {
+		return list, fmt.Errorf("unable to build acls: %v", diags)
+	}
+	return list, nil
+}
+
+// validateACLEntries returns a description of every problem found in the entries.
+func validateACLEntries(entries []aclEntry) []string {
+	var problems []string
+
+	seen := map[string]bool{}
+	for _, e := range entries {
+		if e.Name.IsUnknown() || e.Name.IsNull() {
+			continue
+		}
+
+		name := e.Name.ValueString()
+		if seen[name] {
+			problems = append(problems, fmt.Sprintf("ACL name %q is used more than once.", name))
+		}
+		seen[name] = true
+	}
+
+	return problems
+}
+
+func convertACLEntryToStruct(e *aclEntry) *unbound.ACL {
+	return &unbound.ACL{
+		Enabled:     tools.BoolToString(e.Enabled.ValueBool()),
+		Name:        e.Name.ValueString(),
+		Action:      api.SelectedMap(e.Action.ValueString()),
+		Networks:    api.SelectedMapList(tools.SetToStringSlice(e.Networks)),
+		Description: e.Description.ValueString(),
+	}
+}
+
+func convertACLStructToEntry(id string, acl *unbound.ACL) aclEntry {
+	return aclEntry{
+		Enabled:     types.BoolValue(tools.StringToBool(acl.Enabled)),
+		Name:        types.StringValue(acl.Name),
+		Action:      types.StringValue(acl.Action.String()),
+		Networks:    tools.StringSliceToSet(acl.Networks),
+		Description: types.StringValue(acl.Description),
+		Id:          types.StringValue(id),
+	}
+}
+
+func convertACLSearchRowToEntry(row unbound.ACLSearchRow) aclEntry {
+	networks := []string{}
+	if row.Networks != "" {
+		networks = strings.Split(row.Networks, ",")
+	}
+
+	return aclEntry{
+		Enabled:     types.BoolValue(tools.StringToBool(row.Enabled)),
+		Name:        types.StringValue(row.Name),
+		Action:      types.StringValue(row.Action),
+		Networks:    tools.StringSliceToSet(networks),
+		Description: types.StringValue(row.Description),
+		Id:          types.StringValue(row.UUID),
+	}
+}
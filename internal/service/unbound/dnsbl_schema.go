@@ -0,0 +1,243 @@
+package unbound
+
+import (
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsblResourceModel describes the resource data model.
+type dnsblResourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Description     types.String `tfsdk:"description"`
+	Providers       types.Set    `tfsdk:"providers"`
+	CustomURLs      types.Set    `tfsdk:"custom_urls"`
+	Allowlist       types.Set    `tfsdk:"allowlist"`
+	Blocklist       types.Set    `tfsdk:"blocklist"`
+	Wildcards       types.Set    `tfsdk:"wildcards"`
+	SourceNetworks  types.Set    `tfsdk:"source_networks"`
+	Address         types.String `tfsdk:"address"`
+	NXDomain        types.Bool   `tfsdk:"nxdomain"`
+	RefreshInterval types.Int64  `tfsdk:"refresh_interval"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// dnsblDataSourceModel describes the data source data model.
+type dnsblDataSourceModel struct {
+	dnsblResourceModel
+
+	EntryCount types.Int64 `tfsdk:"entry_count"`
+}
+
+func dnsblResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure DNS blocklist (DNSBL) policies for Unbound. Queries for blocked domains from the policy's source networks are answered with `address` or NXDOMAIN.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this blocklist policy. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this blocklist policy. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"providers": schema.SetAttribute{
+				MarkdownDescription: "The predefined blocklists to use, by their OPNsense key (e.g. `ag` for AdGuard, `el` for EasyList). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"custom_urls": schema.SetAttribute{
+				MarkdownDescription: "URLs of additional blocklists to download. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"allowlist": schema.SetAttribute{
+				MarkdownDescription: "Domains that are never blocked, even when they appear on a blocklist. Regular expressions are supported. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"blocklist": schema.SetAttribute{
+				MarkdownDescription: "Additional domains to block. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"wildcards": schema.SetAttribute{
+				MarkdownDescription: "Domains to block including all of their subdomains. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"source_networks": schema.SetAttribute{
+				MarkdownDescription: "Client networks this policy applies to, in CIDR notation (e.g. `192.168.1.0/24`). Set to `[]` to apply it to all clients. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.CIDR()),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The address returned for blocked domains. Ignored when `nxdomain` is enabled. Defaults to `\"0.0.0.0\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0.0.0.0"),
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"nxdomain": schema.BoolAttribute{
+				MarkdownDescription: "Answer queries for blocked domains with NXDOMAIN instead of `address`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"refresh_interval": schema.Int64Attribute{
+				MarkdownDescription: "How often in hours the blocklists are downloaded again. Set to `-1` to only download them when Unbound is reconfigured. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 168),
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the blocklist policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func dnsblDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure DNS blocklist (DNSBL) policies for Unbound.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Enable this blocklist policy.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this blocklist policy.",
+				Computed:            true,
+			},
+			"providers": dschema.SetAttribute{
+				MarkdownDescription: "The predefined blocklists to use.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_urls": dschema.SetAttribute{
+				MarkdownDescription: "URLs of additional blocklists to download.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowlist": dschema.SetAttribute{
+				MarkdownDescription: "Domains that are never blocked.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"blocklist": dschema.SetAttribute{
+				MarkdownDescription: "Additional domains to block.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"wildcards": dschema.SetAttribute{
+				MarkdownDescription: "Domains to block including all of their subdomains.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_networks": dschema.SetAttribute{
+				MarkdownDescription: "Client networks this policy applies to.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"address": dschema.StringAttribute{
+				MarkdownDescription: "The address returned for blocked domains.",
+				Computed:            true,
+			},
+			"nxdomain": dschema.BoolAttribute{
+				MarkdownDescription: "Answer queries for blocked domains with NXDOMAIN.",
+				Computed:            true,
+			},
+			"refresh_interval": dschema.Int64Attribute{
+				MarkdownDescription: "How often in hours the blocklists are downloaded again, or `-1` if only on reconfigure.",
+				Computed:            true,
+			},
+			"entry_count": dschema.Int64Attribute{
+				MarkdownDescription: "The number of blocklist entries currently loaded by Unbound, across all policies. Null if no blocklist has been loaded yet.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDNSBLSchemaToStruct(d *dnsblResourceModel) (*unbound.DNSBL, error) {
+	return &unbound.DNSBL{
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		Description:     d.Description.ValueString(),
+		Providers:       api.SelectedMapList(tools.SetToStringSlice(d.Providers)),
+		CustomURLs:      api.SelectedMapList(tools.SetToStringSlice(d.CustomURLs)),
+		Allowlist:       api.SelectedMapList(tools.SetToStringSlice(d.Allowlist)),
+		Blocklist:       api.SelectedMapList(tools.SetToStringSlice(d.Blocklist)),
+		Wildcards:       api.SelectedMapList(tools.SetToStringSlice(d.Wildcards)),
+		SourceNetworks:  api.SelectedMapList(tools.SetToStringSlice(d.SourceNetworks)),
+		Address:         d.Address.ValueString(),
+		NXDomain:        tools.BoolToString(d.NXDomain.ValueBool()),
+		RefreshInterval: tools.Int64ToStringNegative(d.RefreshInterval.ValueInt64()),
+	}, nil
+}
+
+func convertDNSBLStructToSchema(d *unbound.DNSBL) (*dnsblResourceModel, error) {
+	return &dnsblResourceModel{
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:     types.StringValue(d.Description),
+		Providers:       tools.StringSliceToSet(d.Providers),
+		CustomURLs:      tools.StringSliceToSet(d.CustomURLs),
+		Allowlist:       tools.StringSliceToSet(d.Allowlist),
+		Blocklist:       tools.StringSliceToSet(d.Blocklist),
+		Wildcards:       tools.StringSliceToSet(d.Wildcards),
+		SourceNetworks:  tools.StringSliceToSet(d.SourceNetworks),
+		Address:         types.StringValue(d.Address),
+		NXDomain:        types.BoolValue(tools.StringToBool(d.NXDomain)),
+		RefreshInterval: types.Int64Value(tools.StringToInt64(d.RefreshInterval)),
+	}, nil
+}
@@ -0,0 +1,145 @@
+package unbound
+
+import (
+	"context"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertDNSBLSchemaToStruct(t *testing.T) {
+	input := &dnsblResourceModel{
+		Enabled:         types.BoolValue(true),
+		Description:     types.StringValue("ads"),
+		Providers:       tools.StringSliceToSet([]string{"hgz002", "sb"}),
+		CustomURLs:      tools.StringSliceToSet([]string{"https://example.com/hosts.txt"}),
+		Allowlist:       tools.StringSliceToSet([]string{"allowed.example.com"}),
+		Blocklist:       tools.StringSliceToSet([]string{}),
+		Wildcards:       tools.StringSliceToSet([]string{"tracker.example.com"}),
+		SourceNetworks:  tools.StringSliceToSet([]string{"192.168.1.0/24"}),
+		Address:         types.StringValue("0.0.0.0"),
+		NXDomain:        types.BoolValue(false),
+		RefreshInterval: types.Int64Value(-1),
+		Id:              types.StringValue("dnsbl-id"),
+	}
+
+	expected := &unbound.DNSBL{
+		Enabled:         "1",
+		Description:     "ads",
+		Providers:       api.SelectedMapList{"hgz002", "sb"},
+		CustomURLs:      api.SelectedMapList{"https://example.com/hosts.txt"},
+		Allowlist:       api.SelectedMapList{"allowed.example.com"},
+		Blocklist:       api.SelectedMapList{},
+		Wildcards:       api.SelectedMapList{"tracker.example.com"},
+		SourceNetworks:  api.SelectedMapList{"192.168.1.0/24"},
+		Address:         "0.0.0.0",
+		NXDomain:        "0",
+		RefreshInterval: "",
+	}
+
+	result, err := convertDNSBLSchemaToStruct(input)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestConvertDNSBLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *dnsblResourceModel
+	}{
+		{
+			name: "defaults",
+			input: &dnsblResourceModel{
+				Enabled:         types.BoolValue(true),
+				Description:     types.StringValue(""),
+				Providers:       tools.StringSliceToSet([]string{}),
+				CustomURLs:      tools.StringSliceToSet([]string{}),
+				Allowlist:       tools.StringSliceToSet([]string{}),
+				Blocklist:       tools.StringSliceToSet([]string{}),
+				Wildcards:       tools.StringSliceToSet([]string{}),
+				SourceNetworks:  tools.StringSliceToSet([]string{}),
+				Address:         types.StringValue("0.0.0.0"),
+				NXDomain:        types.BoolValue(false),
+				RefreshInterval: types.Int64Value(-1),
+			},
+		},
+		{
+			name: "full",
+			input: &dnsblResourceModel{
+				Enabled:         types.BoolValue(false),
+				Description:     types.StringValue("kids"),
+				Providers:       tools.StringSliceToSet([]string{"oisd1"}),
+				CustomURLs:      tools.StringSliceToSet([]string{"https://example.com/a.txt", "https://example.com/b.txt"}),
+				Allowlist:       tools.StringSliceToSet([]string{"school.example.com"}),
+				Blocklist:       tools.StringSliceToSet([]string{"games.example.com"}),
+				Wildcards:       tools.StringSliceToSet([]string{}),
+				SourceNetworks:  tools.StringSliceToSet([]string{"10.0.10.0/24", "2001:db8:10::/64"}),
+				Address:         types.StringValue("::"),
+				NXDomain:        types.BoolValue(true),
+				RefreshInterval: types.Int64Value(24),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dnsbl, err := convertDNSBLSchemaToStruct(tt.input)
+			assert.NoError(t, err)
+
+			result, err := convertDNSBLStructToSchema(dnsbl)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input, result)
+		})
+	}
+}
+
+func TestDNSBLSchemaValidation(t *testing.T) {
+	attributes := dnsblResourceSchema().Attributes
+
+	t.Run("source_networks", func(t *testing.T) {
+		attr, ok := attributes["source_networks"].(schema.SetAttribute)
+		require.True(t, ok)
+
+		for value, valid := range map[string]bool{"192.168.1.0/24": true, "2001:db8::/64": true, "192.168.1.1": false} {
+			resp := &validator.SetResponse{}
+			for _, v := range attr.Validators {
+				v.ValidateSet(context.Background(), validator.SetRequest{
+					Path:        path.Root("source_networks"),
+					ConfigValue: tools.StringSliceToSet([]string{value}),
+				}, resp)
+			}
+			assert.Equal(t, valid, !resp.Diagnostics.HasError(), value)
+		}
+	})
+
+	t.Run("refresh_interval", func(t *testing.T) {
+		attr, ok := attributes["refresh_interval"].(schema.Int64Attribute)
+		require.True(t, ok)
+
+		for value, valid := range map[int64]bool{-1: true, 1: true, 168: true, 0: false, 169: false} {
+			resp := &validator.Int64Response{}
+			for _, v := range attr.Validators {
+				v.ValidateInt64(context.Background(), validator.Int64Request{
+					Path:        path.Root("refresh_interval"),
+					ConfigValue: types.Int64Value(value),
+				}, resp)
+			}
+			assert.Equal(t, valid, !resp.Diagnostics.HasError(), value)
+		}
+	})
+}
+
+func TestDNSBLDataSourceSchema(t *testing.T) {
+	schema := dnsblDataSourceSchema()
+
+	assert.True(t, schema.Attributes["id"].IsRequired())
+	assert.True(t, schema.Attributes["entry_count"].IsComputed())
+}
@@ -9,18 +9,26 @@ import (
 
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		newACLResource,
+		newDNSBLResource,
 		newDomainOverrideResource,
 		newForwardResource,
 		newHostAliasResource,
 		newHostOverrideResource,
+		newSettingsResource,
+		newZoneResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newACLDataSource,
+		newDNSBLDataSource,
 		newDomainOverrideDataSource,
 		newForwardDataSource,
+		newForwardersDataSource,
 		newHostAliasDataSource,
 		newHostOverrideDataSource,
+		newSettingsDataSource,
 	}
 }
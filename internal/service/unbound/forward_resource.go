@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +18,7 @@ import (
 var _ resource.Resource = &forwardResource{}
 var _ resource.ResourceWithConfigure = &forwardResource{}
 var _ resource.ResourceWithImportState = &forwardResource{}
+var _ resource.ResourceWithValidateConfig = &forwardResource{}
 
 func newForwardResource() resource.Resource {
 	return &forwardResource{}
@@ -54,6 +55,27 @@ func (r *forwardResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *forwardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *forwardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if data.Type.IsUnknown() || data.VerifyCN.IsUnknown() {
+		return
+	}
+
+	// Without a name to verify, the TLS certificate of the server is accepted as is
+	if data.Type.ValueString() == "dot" && (data.VerifyCN.IsNull() || data.VerifyCN.ValueString() == "") {
+		resp.Diagnostics.AddAttributeError(path.Root("verify_cn"), "Missing Attribute Configuration",
+			"verify_cn must be set when type is dot, so the TLS certificate of the server can be verified.")
+	}
+}
+
 func (r *forwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *forwardResourceModel
 
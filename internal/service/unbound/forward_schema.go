@@ -1,8 +1,10 @@
 package unbound
 
 import (
-	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -10,16 +12,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // forwardResourceModel describes the resource data model.
 type forwardResourceModel struct {
-	Enabled    types.Bool   `tfsdk:"enabled"`
-	Domain     types.String `tfsdk:"domain"`
-	ServerIP   types.String `tfsdk:"server_ip"`
-	ServerPort types.Int64  `tfsdk:"server_port"`
-	VerifyCN   types.String `tfsdk:"verify_cn"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Type         types.String `tfsdk:"type"`
+	Domain       types.String `tfsdk:"domain"`
+	ServerIP     types.String `tfsdk:"server_ip"`
+	ServerPort   types.Int64  `tfsdk:"server_port"`
+	VerifyCN     types.String `tfsdk:"verify_cn"`
+	ForwardFirst types.Bool   `tfsdk:"forward_first"`
 
 	Id types.String `tfsdk:"id"`
 }
@@ -35,6 +40,17 @@ func forwardResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "How queries are sent to the server. Use `forward` for plain DNS, or `dot` for DNS-over-TLS. If not set, new forwards use `forward` and existing forwards keep the type configured in OPNsense.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("forward", "dot"),
+				},
+			},
 			"domain": schema.StringAttribute{
 				MarkdownDescription: "If a domain is entered here, queries for this specific domain will be forwarded to the specified server. Set to `\"\"` to forward all queries to the specified server.",
 				Required:            true,
@@ -50,11 +66,17 @@ func forwardResourceSchema() schema.Schema {
 				Default:             int64default.StaticInt64(53),
 			},
 			"verify_cn": schema.StringAttribute{
-				MarkdownDescription: "The Common Name of the DNS server (e.g. `dns.example.com`). This field is required to verify its TLS certificate. DNS-over-TLS is susceptible to man-in-the-middle attacks unless certificates can be verified. Set to `\"\"` to accept self-signed yet also potentially fraudulent certificates. Must be set when `type` is `dot`.",
+				MarkdownDescription: "The Common Name of the DNS server (e.g. `dns.example.com`). This field is required to verify its TLS certificate. DNS-over-TLS is susceptible to man-in-the-middle attacks unless certificates can be verified. Must be set when `type` is `dot`. Defaults to `\"\"`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"forward_first": schema.BoolAttribute{
+				MarkdownDescription: "Fall back to resolving the query recursively when the server does not answer it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the forward.",
@@ -79,6 +101,10 @@ func forwardDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Whether this route is enabled.",
 				Computed:            true,
 			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "How queries are sent to the server, either `forward` for plain DNS or `dot` for DNS-over-TLS.",
+				Computed:            true,
+			},
 			"domain": dschema.StringAttribute{
 				MarkdownDescription: "If a domain is entered here, queries for this specific domain will be forwarded to the specified server.",
 				Computed:            true,
@@ -95,26 +121,40 @@ func forwardDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "The Common Name of the DNS server (e.g. `dns.example.com`). This field is required to verify its TLS certificate. DNS-over-TLS is susceptible to man-in-the-middle attacks unless certificates can be verified.",
 				Computed:            true,
 			},
+			"forward_first": dschema.BoolAttribute{
+				MarkdownDescription: "Whether queries fall back to recursive resolution when the server does not answer them.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
 func convertForwardSchemaToStruct(d *forwardResourceModel) (*unbound.Forward, error) {
+	// Type is only unknown when creating a forward without one, use the OPNsense default
+	forwardType := d.Type.ValueString()
+	if forwardType == "" {
+		forwardType = "forward"
+	}
+
 	return &unbound.Forward{
-		Enabled:  tools.BoolToString(d.Enabled.ValueBool()),
-		Domain:   d.Domain.ValueString(),
-		Server:   d.ServerIP.ValueString(),
-		Port:     tools.Int64ToString(d.ServerPort.ValueInt64()),
-		VerifyCN: d.VerifyCN.ValueString(),
+		Enabled:      tools.BoolToString(d.Enabled.ValueBool()),
+		Type:         api.SelectedMap(forwardType),
+		Domain:       d.Domain.ValueString(),
+		Server:       d.ServerIP.ValueString(),
+		Port:         tools.Int64ToString(d.ServerPort.ValueInt64()),
+		VerifyCN:     d.VerifyCN.ValueString(),
+		ForwardFirst: tools.BoolToString(d.ForwardFirst.ValueBool()),
 	}, nil
 }
 
 func convertForwardStructToSchema(d *unbound.Forward) (*forwardResourceModel, error) {
 	return &forwardResourceModel{
-		Enabled:    types.BoolValue(tools.StringToBool(d.Enabled)),
-		Domain:     types.StringValue(d.Domain),
-		ServerIP:   types.StringValue(d.Server),
-		ServerPort: types.Int64Value(tools.StringToInt64(d.Port)),
-		VerifyCN:   types.StringValue(d.VerifyCN),
+		Enabled:      types.BoolValue(tools.StringToBool(d.Enabled)),
+		Type:         types.StringValue(d.Type.String()),
+		Domain:       types.StringValue(d.Domain),
+		ServerIP:     types.StringValue(d.Server),
+		ServerPort:   types.Int64Value(tools.StringToInt64(d.Port)),
+		VerifyCN:     types.StringValue(d.VerifyCN),
+		ForwardFirst: types.BoolValue(tools.StringToBool(d.ForwardFirst)),
 	}, nil
 }
@@ -0,0 +1,171 @@
+package unbound
+
+import (
+	"context"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type forwardersDataSourceModel struct {
+	ForwardingMode types.String `tfsdk:"forwarding_mode"`
+	UseSystemNS    types.Bool   `tfsdk:"use_system_nameservers"`
+	Forwarders     types.List   `tfsdk:"forwarders"`
+}
+
+type forwarderEntryModel struct {
+	Id           types.String `tfsdk:"id"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Type         types.String `tfsdk:"type"`
+	Domain       types.String `tfsdk:"domain"`
+	ServerIP     types.String `tfsdk:"server_ip"`
+	ServerPort   types.Int64  `tfsdk:"server_port"`
+	VerifyCN     types.String `tfsdk:"verify_cn"`
+	ForwardFirst types.Bool   `tfsdk:"forward_first"`
+	Status       types.String `tfsdk:"status"`
+}
+
+var forwarderEntryAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"enabled":       types.BoolType,
+	"type":          types.StringType,
+	"domain":        types.StringType,
+	"server_ip":     types.StringType,
+	"server_port":   types.Int64Type,
+	"verify_cn":     types.StringType,
+	"forward_first": types.BoolType,
+	"status":        types.StringType,
+}
+
+func forwardersDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Forwarders can be used to get all query forwards of Unbound, and whether they are loaded by the running resolver.",
+
+		Attributes: map[string]schema.Attribute{
+			"forwarding_mode": schema.StringAttribute{
+				MarkdownDescription: "How queries that do not match a domain forward are resolved. One of `recursive`, `forward`.",
+				Computed:            true,
+			},
+			"use_system_nameservers": schema.BoolAttribute{
+				MarkdownDescription: "Whether queries are forwarded to the system nameservers.",
+				Computed:            true,
+			},
+			"forwarders": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all query forwards configured in OPNsense.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the forward.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether this forward is enabled.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "How queries are sent to the server. One of `forward`, `dot`.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain whose queries are forwarded, or `\"\"` for all queries.",
+							Computed:            true,
+						},
+						"server_ip": schema.StringAttribute{
+							MarkdownDescription: "IP address of the DNS server.",
+							Computed:            true,
+						},
+						"server_port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the DNS server.",
+							Computed:            true,
+						},
+						"verify_cn": schema.StringAttribute{
+							MarkdownDescription: "The Common Name used to verify the TLS certificate of the server.",
+							Computed:            true,
+						},
+						"forward_first": schema.BoolAttribute{
+							MarkdownDescription: "Whether queries fall back to recursive resolution when the server does not answer them.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Whether the running resolver uses this forward. One of `active`, `inactive` (enabled, but not loaded yet), `disabled`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// forwardZoneName returns the zone name Unbound reports for a forwarded domain.
+func forwardZoneName(domain string) string {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	if domain == "" {
+		return "."
+	}
+	return domain + "."
+}
+
+// forwardLoaded reports whether the server is listed for the domain in the running resolver.
+// Unbound reports servers as `address`, `address@port` or `address@port#name`.
+func forwardLoaded(loaded []unbound.ForwardZone, domain string, server string, port string) bool {
+	zone := forwardZoneName(domain)
+	for _, z := range loaded {
+		if forwardZoneName(z.Name) != zone {
+			continue
+		}
+
+		for _, s := range z.Servers {
+			address, rest, _ := strings.Cut(strings.SplitN(s, "#", 2)[0], "@")
+			if address == server && (rest == "" || rest == port) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func convertForwardersStructToSchema(settings *unbound.SettingsGetResponse, rows []unbound.ForwardRow, loaded []unbound.ForwardZone) (*forwardersDataSourceModel, error) {
+	// Creating an empty slice results in `[]` rather than `null` if OPNsense API returned an empty list.
+	forwarders := []forwarderEntryModel{}
+	for _, elem := range rows {
+		status := "disabled"
+		if tools.StringToBool(elem.Enabled) {
+			status = "inactive"
+			if forwardLoaded(loaded, elem.Domain, elem.Server, elem.Port) {
+				status = "active"
+			}
+		}
+
+		forwarders = append(forwarders, forwarderEntryModel{
+			Id:           types.StringValue(elem.UUID),
+			Enabled:      types.BoolValue(tools.StringToBool(elem.Enabled)),
+			Type:         types.StringValue(elem.Type),
+			Domain:       types.StringValue(elem.Domain),
+			ServerIP:     types.StringValue(elem.Server),
+			ServerPort:   tools.StringToInt64Null(elem.Port),
+			VerifyCN:     types.StringValue(elem.VerifyCN),
+			ForwardFirst: types.BoolValue(tools.StringToBool(elem.ForwardFirst)),
+			Status:       types.StringValue(status),
+		})
+	}
+
+	v, _ := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{}.WithAttributeTypes(forwarderEntryAttrTypes),
+		forwarders,
+	)
+
+	forwarding := settings.Unbound.Forwarding
+
+	return &forwardersDataSourceModel{
+		ForwardingMode: types.StringValue(selectedOptionKey(forwarding.Mode)),
+		UseSystemNS:    types.BoolValue(tools.StringToBool(forwarding.Enabled)),
+		Forwarders:     v,
+	}, nil
+}
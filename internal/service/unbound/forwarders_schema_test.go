@@ -0,0 +1,39 @@
+package unbound
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardLoaded(t *testing.T) {
+	loaded := []unbound.ForwardZone{
+		{Name: ".", Servers: []string{"9.9.9.9@853#dns.quad9.net", "1.1.1.1"}},
+		{Name: "example.com.", Servers: []string{"192.0.2.53@5353", "2001:db8::53@53#ns.example.com"}},
+	}
+
+	tests := []struct {
+		name   string
+		domain string
+		server string
+		port   string
+		want   bool
+	}{
+		{name: "address_port_name", domain: "", server: "9.9.9.9", port: "853", want: true},
+		{name: "address_only", domain: "", server: "1.1.1.1", port: "53", want: true},
+		{name: "address_port", domain: "example.com", server: "192.0.2.53", port: "5353", want: true},
+		{name: "ipv6_address_port_name", domain: "example.com", server: "2001:db8::53", port: "53", want: true},
+		{name: "domain_case_and_trailing_dot", domain: "Example.COM.", server: "192.0.2.53", port: "5353", want: true},
+		{name: "port_mismatch", domain: "", server: "9.9.9.9", port: "53", want: false},
+		{name: "name_is_not_the_address", domain: "", server: "dns.quad9.net", port: "853", want: false},
+		{name: "other_zone", domain: "example.com", server: "9.9.9.9", port: "853", want: false},
+		{name: "unknown_zone", domain: "example.org", server: "192.0.2.53", port: "5353", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, forwardLoaded(loaded, tt.domain, tt.server, tt.port))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package unbound
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func settingsResponseToModel(resp *unbound.SettingsGetResponse) settingsResourceModel {
+	general := resp.Unbound.General
+	advanced := resp.Unbound.Advanced
+	forwarding := resp.Unbound.Forwarding
+
+	return settingsResourceModel{
+		Enabled:            types.BoolValue(tools.StringToBool(general.Enabled)),
+		Port:               types.Int64Value(tools.StringToInt64(general.Port)),
+		ListenInterfaces:   tools.StringSliceToSet(selectedOptionKeys(general.ActiveInterface)),
+		DNSSEC:             types.BoolValue(tools.StringToBool(general.DNSSEC)),
+		DNS64:              types.BoolValue(tools.StringToBool(general.DNS64)),
+		DNS64Prefix:        types.StringValue(general.DNS64Prefix),
+		RegisterDHCPLeases: types.BoolValue(tools.StringToBool(general.RegisterDHCP)),
+		RegisterDHCPStatic: types.BoolValue(tools.StringToBool(general.RegisterDHCPStatic)),
+		LocalZoneType:      types.StringValue(selectedOptionKey(general.LocalZoneType)),
+		Prefetch:           types.BoolValue(tools.StringToBool(advanced.Prefetch)),
+		PrefetchKey:        types.BoolValue(tools.StringToBool(advanced.PrefetchKey)),
+		ServeExpired:       types.BoolValue(tools.StringToBool(advanced.ServeExpired)),
+		ServeExpiredTTL:    types.Int64Value(tools.StringToInt64(advanced.ServeExpiredTTL)),
+		MessageCacheSize:   types.StringValue(selectedOptionKey(advanced.MessageCacheSize)),
+		RRSetCacheSize:     types.StringValue(selectedOptionKey(advanced.RRSetCacheSize)),
+		QnameMinimisation:  types.BoolValue(tools.StringToBool(advanced.QnameMinimisation)),
+		QnameMinStrict:     types.BoolValue(tools.StringToBool(advanced.QnameMinimisationStrict)),
+		PrivateDomains:     tools.StringSliceToSet(selectedOptionKeys(advanced.PrivateDomain)),
+		InsecureDomains:    tools.StringSliceToSet(selectedOptionKeys(advanced.InsecureDomain)),
+		ForwardingMode:     types.StringValue(selectedOptionKey(forwarding.Mode)),
+		UseSystemNS:        types.BoolValue(tools.StringToBool(forwarding.Enabled)),
+	}
+}
+
+func (m *settingsResourceModel) toSettingsSetRequest() unbound.SettingsSetRequest {
+	if m == nil {
+		return unbound.SettingsSetRequest{}
+	}
+
+	return unbound.SettingsSetRequest{
+		General: unbound.GeneralSet{
+			Enabled:            boolToAPIString(m.Enabled),
+			Port:               int64ToAPIString(m.Port),
+			ActiveInterface:    strings.Join(tools.SetToStringSlice(m.ListenInterfaces), ","),
+			DNSSEC:             boolToAPIString(m.DNSSEC),
+			DNS64:              boolToAPIString(m.DNS64),
+			DNS64Prefix:        stringToAPIValue(m.DNS64Prefix),
+			RegisterDHCP:       boolToAPIString(m.RegisterDHCPLeases),
+			RegisterDHCPStatic: boolToAPIString(m.RegisterDHCPStatic),
+			LocalZoneType:      stringToAPIValue(m.LocalZoneType),
+		},
+		Advanced: unbound.AdvancedSet{
+			Prefetch:                boolToAPIString(m.Prefetch),
+			PrefetchKey:             boolToAPIString(m.PrefetchKey),
+			ServeExpired:            boolToAPIString(m.ServeExpired),
+			ServeExpiredTTL:         int64ToAPIString(m.ServeExpiredTTL),
+			MessageCacheSize:        stringToAPIValue(m.MessageCacheSize),
+			RRSetCacheSize:          stringToAPIValue(m.RRSetCacheSize),
+			QnameMinimisation:       boolToAPIString(m.QnameMinimisation),
+			QnameMinimisationStrict: boolToAPIString(m.QnameMinStrict),
+			PrivateDomain:           strings.Join(tools.SetToStringSlice(m.PrivateDomains), ","),
+			InsecureDomain:          strings.Join(tools.SetToStringSlice(m.InsecureDomains), ","),
+		},
+		Forwarding: unbound.ForwardingSet{
+			Enabled: boolToAPIString(m.UseSystemNS),
+			Mode:    stringToAPIValue(m.ForwardingMode),
+		},
+	}
+}
+
+func selectedOptionKeys(options api.FieldOptions) []string {
+	keys := make([]string, 0, len(options))
+	for key, option := range options {
+		if option.Selected == 1 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func selectedOptionKey(options api.FieldOptions) string {
+	keys := selectedOptionKeys(options)
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0]
+}
+
+func boolToAPIString(value types.Bool) string {
+	if value.IsNull() || value.IsUnknown() {
+		return tools.BoolToString(false)
+	}
+	return tools.BoolToString(value.ValueBool())
+}
+
+// int64ToAPIString converts the value to a string, mapping `-1` to an empty value.
+func int64ToAPIString(value types.Int64) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return tools.Int64ToStringNegative(value.ValueInt64())
+}
+
+func stringToAPIValue(value types.String) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return value.ValueString()
+}
@@ -0,0 +1,191 @@
+package unbound
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const settingsTestResponse = `{
+	"unbound": {
+		"general": {
+			"enabled": "1",
+			"port": "53",
+			"active_interface": {
+				"lan": {"value": "LAN", "selected": 1},
+				"opt1": {"value": "DMZ", "selected": true},
+				"wan": {"value": "WAN", "selected": 0}
+			},
+			"dnssec": "1",
+			"dns64": "0",
+			"dns64prefix": "",
+			"regdhcp": "0",
+			"regdhcpstatic": "1",
+			"local_zone_type": {
+				"transparent": {"value": "transparent", "selected": 1},
+				"static": {"value": "static", "selected": 0}
+			}
+		},
+		"advanced": {
+			"prefetch": "1",
+			"prefetchkey": "0",
+			"serveexpired": "0",
+			"serveexpiredttl": "",
+			"msgcachesize": {
+				"": {"value": "Default", "selected": 1},
+				"4m": {"value": "4 MB", "selected": 0}
+			},
+			"rrsetcachesize": {
+				"": {"value": "Default", "selected": 0},
+				"8m": {"value": "8 MB", "selected": 1}
+			},
+			"qnamemin": "1",
+			"qnameminstrict": "0",
+			"privatedomain": [],
+			"insecuredomain": {
+				"example.internal": {"value": "example.internal", "selected": 1}
+			}
+		},
+		"forwarding": {
+			"enabled": "0",
+			"mode": {
+				"": {"value": "Default", "selected": 0},
+				"forward": {"value": "Forward", "selected": 1}
+			}
+		}
+	}
+}`
+
+func settingsTestModel() settingsResourceModel {
+	return settingsResourceModel{
+		Enabled:            types.BoolValue(true),
+		Port:               types.Int64Value(53),
+		ListenInterfaces:   tools.StringSliceToSet([]string{"lan", "opt1"}),
+		DNSSEC:             types.BoolValue(true),
+		DNS64:              types.BoolValue(false),
+		DNS64Prefix:        types.StringValue(""),
+		RegisterDHCPLeases: types.BoolValue(false),
+		RegisterDHCPStatic: types.BoolValue(true),
+		LocalZoneType:      types.StringValue("transparent"),
+		Prefetch:           types.BoolValue(true),
+		PrefetchKey:        types.BoolValue(false),
+		ServeExpired:       types.BoolValue(false),
+		ServeExpiredTTL:    types.Int64Value(-1),
+		MessageCacheSize:   types.StringValue(""),
+		RRSetCacheSize:     types.StringValue("8m"),
+		QnameMinimisation:  types.BoolValue(true),
+		QnameMinStrict:     types.BoolValue(false),
+		PrivateDomains:     tools.StringSliceToSet([]string{}),
+		InsecureDomains:    tools.StringSliceToSet([]string{"example.internal"}),
+		ForwardingMode:     types.StringValue("forward"),
+		UseSystemNS:        types.BoolValue(false),
+	}
+}
+
+func TestSettingsResponseToModel(t *testing.T) {
+	var resp unbound.SettingsGetResponse
+	require.NoError(t, json.Unmarshal([]byte(settingsTestResponse), &resp))
+
+	assert.Equal(t, settingsTestModel(), settingsResponseToModel(&resp))
+}
+
+func TestSettingsToSetRequest(t *testing.T) {
+	model := settingsTestModel()
+
+	expected := unbound.SettingsSetRequest{
+		General: unbound.GeneralSet{
+			Enabled:            "1",
+			Port:               "53",
+			ActiveInterface:    "lan,opt1",
+			DNSSEC:             "1",
+			DNS64:              "0",
+			DNS64Prefix:        "",
+			RegisterDHCP:       "0",
+			RegisterDHCPStatic: "1",
+			LocalZoneType:      "transparent",
+		},
+		Advanced: unbound.AdvancedSet{
+			Prefetch:                "1",
+			PrefetchKey:             "0",
+			ServeExpired:            "0",
+			ServeExpiredTTL:         "",
+			MessageCacheSize:        "",
+			RRSetCacheSize:          "8m",
+			QnameMinimisation:       "1",
+			QnameMinimisationStrict: "0",
+			PrivateDomain:           "",
+			InsecureDomain:          "example.internal",
+		},
+		Forwarding: unbound.ForwardingSet{
+			Enabled: "0",
+			Mode:    "forward",
+		},
+	}
+
+	assert.Equal(t, expected, model.toSettingsSetRequest())
+}
+
+func TestSettingsToSetRequestNil(t *testing.T) {
+	var model *settingsResourceModel
+	assert.Equal(t, unbound.SettingsSetRequest{}, model.toSettingsSetRequest())
+}
+
+func TestSelectedOptionKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		options api.FieldOptions
+		keys    []string
+		key     string
+	}{
+		{
+			name:    "none_selected",
+			options: api.FieldOptions{"a": {Value: "A"}, "b": {Value: "B"}},
+			keys:    []string{},
+			key:     "",
+		},
+		{
+			name:    "one_selected",
+			options: api.FieldOptions{"a": {Value: "A"}, "b": {Value: "B", Selected: 1}},
+			keys:    []string{"b"},
+			key:     "b",
+		},
+		{
+			name:    "several_selected_are_sorted",
+			options: api.FieldOptions{"c": {Selected: 1}, "a": {Selected: 1}, "b": {}},
+			keys:    []string{"a", "c"},
+			key:     "a",
+		},
+		{
+			name:    "empty",
+			options: api.FieldOptions{},
+			keys:    []string{},
+			key:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.keys, selectedOptionKeys(tt.options))
+			assert.Equal(t, tt.key, selectedOptionKey(tt.options))
+		})
+	}
+}
+
+func TestSettingsAPIValues(t *testing.T) {
+	assert.Equal(t, "1", boolToAPIString(types.BoolValue(true)))
+	assert.Equal(t, "0", boolToAPIString(types.BoolNull()))
+	assert.Equal(t, "0", boolToAPIString(types.BoolUnknown()))
+
+	assert.Equal(t, "3600", int64ToAPIString(types.Int64Value(3600)))
+	assert.Equal(t, "", int64ToAPIString(types.Int64Value(-1)))
+	assert.Equal(t, "", int64ToAPIString(types.Int64Null()))
+
+	assert.Equal(t, "64:ff9b::/96", stringToAPIValue(types.StringValue("64:ff9b::/96")))
+	assert.Equal(t, "", stringToAPIValue(types.StringUnknown()))
+}
@@ -0,0 +1,174 @@
+package unbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &settingsResource{}
+var _ resource.ResourceWithConfigure = &settingsResource{}
+
+func newSettingsResource() resource.Resource {
+	return &settingsResource{}
+}
+
+// settingsResource defines the resource implementation.
+type settingsResource struct {
+	client opnsense.Client
+}
+
+func (r *settingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_settings"
+}
+
+func (r *settingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = settingsResourceSchema()
+}
+
+func (r *settingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+// applySettings saves the settings and reconfigures Unbound so they take effect.
+func (r *settingsResource) applySettings(ctx context.Context, operation string, settingsReq unbound.SettingsSetRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := r.client.Unbound().UnboundSetSettings(ctx, settingsReq)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", operation, err))
+		return diags
+	}
+	if res != nil && res.Result == "failed" {
+		diags.AddError("Client Error", formatActionResultFailure(operation, res))
+		return diags
+	}
+
+	res, err = r.client.Unbound().UnboundReconfigure(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to reconfigure unbound, got error: %s", err))
+		return diags
+	}
+	if res != nil && res.Result == "failed" {
+		diags.AddError("Client Error", formatActionResultFailure("reconfigure unbound", res))
+		return diags
+	}
+
+	return diags
+}
+
+func (r *settingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *settingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings always exist in OPNsense, so creating is an update
+	resp.Diagnostics.Append(r.applySettings(ctx, "create unbound settings", data.toSettingsSetRequest())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *settingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get settings from OPNsense unbound API
+	settings, err := r.client.Unbound().UnboundGetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound settings, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	settingsModel := settingsResponseToModel(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsModel)...)
+}
+
+func (r *settingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *settingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update settings in OPNsense unbound
+	resp.Diagnostics.Append(r.applySettings(ctx, "update unbound settings", data.toSettingsSetRequest())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *settingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings cannot be removed, so keep the current settings and disable Unbound instead
+	settings, err := r.client.Unbound().UnboundGetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read current unbound settings during delete, got error: %s", err))
+		return
+	}
+
+	settingsModel := settingsResponseToModel(settings)
+	settingsModel.Enabled = types.BoolValue(false)
+
+	resp.Diagnostics.Append(r.applySettings(ctx, "disable unbound settings", settingsModel.toSettingsSetRequest())...)
+}
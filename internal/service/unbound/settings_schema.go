@@ -0,0 +1,303 @@
+package unbound
+
+import (
+	"regexp"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cacheSizeRegex matches an Unbound memory size, e.g. `4m` or `512k`.
+var cacheSizeRegex = regexp.MustCompile(`^\d+[kmg]?$`)
+
+// settingsResourceModel describes the resource data model.
+type settingsResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Port               types.Int64  `tfsdk:"port"`
+	ListenInterfaces   types.Set    `tfsdk:"listen_interfaces"`
+	DNSSEC             types.Bool   `tfsdk:"dnssec"`
+	DNS64              types.Bool   `tfsdk:"dns64"`
+	DNS64Prefix        types.String `tfsdk:"dns64_prefix"`
+	RegisterDHCPLeases types.Bool   `tfsdk:"register_dhcp_leases"`
+	RegisterDHCPStatic types.Bool   `tfsdk:"register_dhcp_static_mappings"`
+	LocalZoneType      types.String `tfsdk:"local_zone_type"`
+	Prefetch           types.Bool   `tfsdk:"prefetch"`
+	PrefetchKey        types.Bool   `tfsdk:"prefetch_key"`
+	ServeExpired       types.Bool   `tfsdk:"serve_expired"`
+	ServeExpiredTTL    types.Int64  `tfsdk:"serve_expired_ttl"`
+	MessageCacheSize   types.String `tfsdk:"message_cache_size"`
+	RRSetCacheSize     types.String `tfsdk:"rrset_cache_size"`
+	QnameMinimisation  types.Bool   `tfsdk:"qname_minimisation"`
+	QnameMinStrict     types.Bool   `tfsdk:"qname_minimisation_strict"`
+	PrivateDomains     types.Set    `tfsdk:"private_domains"`
+	InsecureDomains    types.Set    `tfsdk:"insecure_domains"`
+	ForwardingMode     types.String `tfsdk:"forwarding_mode"`
+	UseSystemNS        types.Bool   `tfsdk:"use_system_nameservers"`
+}
+
+func settingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general and advanced settings of the Unbound DNS resolver. This is a singleton, only one instance of this resource should exist. Unbound is reconfigured after every change. Destroying it disables Unbound.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable Unbound. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port Unbound listens on. Defaults to `53`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(53),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"listen_interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces Unbound listens on, in lowercase (e.g. `lan`). Set to `[]` to listen on all interfaces. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Enable DNSSEC validation. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dns64": schema.BoolAttribute{
+				MarkdownDescription: "Synthesize AAAA records from A records, for use with NAT64. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dns64_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix used for DNS64 synthesized records. Set to `\"\"` to use `64:ff9b::/96`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"register_dhcp_leases": schema.BoolAttribute{
+				MarkdownDescription: "Register hostnames of DHCP leases, so clients can be resolved by name. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_dhcp_static_mappings": schema.BoolAttribute{
+				MarkdownDescription: "Register hostnames of DHCP static mappings. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"local_zone_type": schema.StringAttribute{
+				MarkdownDescription: "How Unbound answers queries for local data that has no matching record. Available values: `transparent`, `always_nxdomain`, `always_refuse`, `always_transparent`, `deny`, `inform`, `inform_deny`, `nodefault`, `refuse`, `static`, `typetransparent`. Defaults to `\"transparent\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("transparent"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("transparent", "always_nxdomain", "always_refuse", "always_transparent", "deny", "inform", "inform_deny", "nodefault", "refuse", "static", "typetransparent"),
+				},
+			},
+			"prefetch": schema.BoolAttribute{
+				MarkdownDescription: "Refresh popular cache entries before they expire. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"prefetch_key": schema.BoolAttribute{
+				MarkdownDescription: "Fetch DNSKEY records early in the validation process. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"serve_expired": schema.BoolAttribute{
+				MarkdownDescription: "Answer from expired cache entries while they are refreshed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"serve_expired_ttl": schema.Int64Attribute{
+				MarkdownDescription: "How long in seconds expired entries may be served. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"message_cache_size": schema.StringAttribute{
+				MarkdownDescription: "The size of the message cache, e.g. `4m`. Set to `\"\"` to use the Unbound default. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						stringvalidator.RegexMatches(cacheSizeRegex, "must be a size, e.g. 4m"),
+					),
+				},
+			},
+			"rrset_cache_size": schema.StringAttribute{
+				MarkdownDescription: "The size of the RRset cache, e.g. `8m`. Usually twice the `message_cache_size`. Set to `\"\"` to use the Unbound default. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						stringvalidator.RegexMatches(cacheSizeRegex, "must be a size, e.g. 8m"),
+					),
+				},
+			},
+			"qname_minimisation": schema.BoolAttribute{
+				MarkdownDescription: "Send only the minimum required labels of the query name to upstream servers. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"qname_minimisation_strict": schema.BoolAttribute{
+				MarkdownDescription: "Do not fall back to sending the full query name when upstream servers fail minimised queries. Requires `qname_minimisation`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"private_domains": schema.SetAttribute{
+				MarkdownDescription: "Domains allowed to resolve to private addresses, bypassing DNS rebinding protection. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"insecure_domains": schema.SetAttribute{
+				MarkdownDescription: "Domains for which DNSSEC validation is skipped. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				ElementType:         types.StringType,
+			},
+			"forwarding_mode": schema.StringAttribute{
+				MarkdownDescription: "How queries that do not match a domain forward are resolved. `recursive` resolves them from the root servers, `forward` sends them to the forwarders without a domain (see `opnsense_unbound_forward`) or to the system nameservers. Available values: `recursive`, `forward`. Defaults to `\"recursive\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("recursive"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("recursive", "forward"),
+				},
+			},
+			"use_system_nameservers": schema.BoolAttribute{
+				MarkdownDescription: "Forward queries to the nameservers configured in the system settings, or received via DHCP or PPP on the WAN interface. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func settingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general and advanced settings of the Unbound DNS resolver.",
+
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Unbound is enabled.",
+				Computed:            true,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "The port Unbound listens on.",
+				Computed:            true,
+			},
+			"listen_interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces Unbound listens on. Empty if Unbound listens on all interfaces.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dnssec": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DNSSEC validation is enabled.",
+				Computed:            true,
+			},
+			"dns64": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DNS64 is enabled.",
+				Computed:            true,
+			},
+			"dns64_prefix": dschema.StringAttribute{
+				MarkdownDescription: "The prefix used for DNS64 synthesized records.",
+				Computed:            true,
+			},
+			"register_dhcp_leases": dschema.BoolAttribute{
+				MarkdownDescription: "Whether hostnames of DHCP leases are registered.",
+				Computed:            true,
+			},
+			"register_dhcp_static_mappings": dschema.BoolAttribute{
+				MarkdownDescription: "Whether hostnames of DHCP static mappings are registered.",
+				Computed:            true,
+			},
+			"local_zone_type": dschema.StringAttribute{
+				MarkdownDescription: "How Unbound answers queries for local data that has no matching record.",
+				Computed:            true,
+			},
+			"prefetch": dschema.BoolAttribute{
+				MarkdownDescription: "Whether popular cache entries are refreshed before they expire.",
+				Computed:            true,
+			},
+			"prefetch_key": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DNSKEY records are fetched early.",
+				Computed:            true,
+			},
+			"serve_expired": dschema.BoolAttribute{
+				MarkdownDescription: "Whether expired cache entries are served while they are refreshed.",
+				Computed:            true,
+			},
+			"serve_expired_ttl": dschema.Int64Attribute{
+				MarkdownDescription: "How long in seconds expired entries may be served, or `-1` for no limit.",
+				Computed:            true,
+			},
+			"message_cache_size": dschema.StringAttribute{
+				MarkdownDescription: "The size of the message cache.",
+				Computed:            true,
+			},
+			"rrset_cache_size": dschema.StringAttribute{
+				MarkdownDescription: "The size of the RRset cache.",
+				Computed:            true,
+			},
+			"qname_minimisation": dschema.BoolAttribute{
+				MarkdownDescription: "Whether query names are minimised.",
+				Computed:            true,
+			},
+			"qname_minimisation_strict": dschema.BoolAttribute{
+				MarkdownDescription: "Whether strict query name minimisation is enabled.",
+				Computed:            true,
+			},
+			"private_domains": dschema.SetAttribute{
+				MarkdownDescription: "Domains allowed to resolve to private addresses.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"insecure_domains": dschema.SetAttribute{
+				MarkdownDescription: "Domains for which DNSSEC validation is skipped.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"forwarding_mode": dschema.StringAttribute{
+				MarkdownDescription: "How queries that do not match a domain forward are resolved.",
+				Computed:            true,
+			},
+			"use_system_nameservers": dschema.BoolAttribute{
+				MarkdownDescription: "Whether queries are forwarded to the system nameservers.",
+				Computed:            true,
+			},
+		},
+	}
+}
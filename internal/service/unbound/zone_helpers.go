@@ -0,0 +1,220 @@
+package unbound
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/unbound"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zoneChanges lists the host override calls needed to make a domain match the plan.
+type zoneChanges struct {
+	add    []zoneRecord
+	update []zoneRecord
+	remove []zoneRecord
+}
+
+func (c zoneChanges) empty() bool {
+	return len(c.add) == 0 && len(c.update) == 0 && len(c.remove) == 0
+}
+
+func zoneRecordsFromModel(ctx context.Context, data *zoneResourceModel) ([]zoneRecord, error) {
+	var records []zoneRecord
+	if data == nil || data.Records.IsNull() || data.Records.IsUnknown() {
+		return records, nil
+	}
+
+	if diags := data.Records.ElementsAs(ctx, &records, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to parse records: %v", diags)
+	}
+	return records, nil
+}
+
+func zoneRecordsToList(ctx context.Context, records []zoneRecord) (types.List, error) {
+	if records == nil {
+		records = []zoneRecord{}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: zoneRecordAttrTypes}, records)
+	if diags.HasError() {
+		return list, fmt.Errorf("unable to build records: %v", diags)
+	}
+	return list, nil
+}
+
+// zoneRecordKey identifies a record within its zone.
+func zoneRecordKey(r zoneRecord) string {
+	return strings.ToLower(r.Hostname.ValueString()) + "|" + r.Type.ValueString() + "|" + r.Value.ValueString()
+}
+
+// validateZoneRecords returns a description of every problem found in the records.
+func validateZoneRecords(records []zoneRecord) []string {
+	var problems []string
+
+	seen := map[string]bool{}
+	for _, r := range records {
+		if r.Hostname.IsUnknown() || r.Type.IsUnknown() || r.Value.IsUnknown() || r.MXPriority.IsUnknown() {
+			continue
+		}
+
+		name := fmt.Sprintf("%s record %q", r.Type.ValueString(), r.Hostname.ValueString())
+
+		key := zoneRecordKey(r)
+		if seen[key] {
+			problems = append(problems, fmt.Sprintf("The %s with value %q is listed more than once.", name, r.Value.ValueString()))
+		}
+		seen[key] = true
+
+		ip := net.ParseIP(r.Value.ValueString())
+		switch r.Type.ValueString() {
+		case "A":
+			if ip == nil || ip.To4() == nil {
+				problems = append(problems, fmt.Sprintf("The %s must have an IPv4 address as value.", name))
+			}
+		case "AAAA":
+			if ip == nil || ip.To4() != nil {
+				problems = append(problems, fmt.Sprintf("The %s must have an IPv6 address as value.", name))
+			}
+		}
+
+		if r.Type.ValueString() == "MX" && r.MXPriority.ValueInt64() == -1 {
+			problems = append(problems, fmt.Sprintf("The %s must set mx_priority.", name))
+		}
+		if r.Type.ValueString() != "MX" && r.MXPriority.ValueInt64() != -1 {
+			problems = append(problems, fmt.Sprintf("The %s can only set mx_priority when type is MX.", name))
+		}
+	}
+
+	return problems
+}
+
+// planZoneChanges matches the planned records against the existing host overrides of the
+// domain by hostname, type and value. Matching records keep their host override and are
+// only updated when they differ, all other host overrides are removed.
+func planZoneChanges(planned []zoneRecord, existing []zoneRecord) zoneChanges {
+	var changes zoneChanges
+
+	current := map[string]zoneRecord{}
+	for _, r := range existing {
+		key := zoneRecordKey(r)
+		if _, ok := current[key]; ok {
+			// Duplicate host overrides cannot be told apart, so only keep the first one
+			changes.remove = append(changes.remove, r)
+			continue
+		}
+		current[key] = r
+	}
+
+	for _, r := range planned {
+		key := zoneRecordKey(r)
+
+		e, ok := current[key]
+		if !ok {
+			changes.add = append(changes.add, r)
+			continue
+		}
+		delete(current, key)
+
+		r.Id = e.Id
+		if !r.Enabled.Equal(e.Enabled) || !r.MXPriority.Equal(e.MXPriority) || !r.Description.Equal(e.Description) {
+			changes.update = append(changes.update, r)
+		}
+	}
+
+	for _, r := range existing {
+		if e, ok := current[zoneRecordKey(r)]; ok && e.Id.Equal(r.Id) {
+			changes.remove = append(changes.remove, r)
+		}
+	}
+
+	return changes
+}
+
+// orderZoneRecords returns the records in the order of the reference records, followed by
+// any other records sorted by hostname, type and value.
+func orderZoneRecords(records []zoneRecord, reference []zoneRecord) []zoneRecord {
+	position := map[string]int{}
+	for i, r := range reference {
+		position[zoneRecordKey(r)] = i
+	}
+
+	sorted := make([]zoneRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, iok := position[zoneRecordKey(sorted[i])]
+		pj, jok := position[zoneRecordKey(sorted[j])]
+		switch {
+		case iok && jok:
+			return pi < pj
+		case iok != jok:
+			return iok
+		default:
+			return zoneRecordKey(sorted[i]) < zoneRecordKey(sorted[j])
+		}
+	})
+	return sorted
+}
+
+func (r *zoneRecord) toHostOverride(domain string) unbound.HostOverrideSet {
+	override := unbound.HostOverrideSet{
+		Enabled:     boolToAPIString(r.Enabled),
+		Hostname:    r.Hostname.ValueString(),
+		Domain:      domain,
+		RR:          r.Type.ValueString(),
+		Description: stringToAPIValue(r.Description),
+	}
+
+	switch r.Type.ValueString() {
+	case "MX":
+		override.MX = r.Value.ValueString()
+		override.MXPrio = int64ToAPIString(r.MXPriority)
+	case "TXT":
+		override.TXTData = r.Value.ValueString()
+	default:
+		override.Server = r.Value.ValueString()
+	}
+
+	return override
+}
+
+func hostOverrideRowToZoneRecord(row unbound.HostOverrideRow) zoneRecord {
+	value := row.Server
+	switch row.RR {
+	case "MX":
+		value = row.MX
+	case "TXT":
+		value = row.TXTData
+	}
+
+	return zoneRecord{
+		Enabled:     types.BoolValue(tools.StringToBool(row.Enabled)),
+		Hostname:    types.StringValue(row.Hostname),
+		Type:        types.StringValue(row.RR),
+		Value:       types.StringValue(value),
+		MXPriority:  types.Int64Value(tools.StringToInt64(row.MXPrio)),
+		Description: types.StringValue(row.Description),
+		Id:          types.StringValue(row.UUID),
+	}
+}
+
+// fetchZoneRecords returns the host overrides of the domain, using a single search.
+func fetchZoneRecords(ctx context.Context, controller *unbound.Controller, domain string) ([]zoneRecord, error) {
+	result, err := controller.UnboundSearchHostOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []zoneRecord
+	for _, row := range result.Rows {
+		if strings.EqualFold(row.Domain, domain) {
+			records = append(records, hostOverrideRowToZoneRecord(row))
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,248 @@
+package unbound
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func zoneTestRecord(hostname, rr, value string) zoneRecord {
+	return zoneRecord{
+		Enabled:     types.BoolValue(true),
+		Hostname:    types.StringValue(hostname),
+		Type:        types.StringValue(rr),
+		Value:       types.StringValue(value),
+		MXPriority:  types.Int64Value(-1),
+		Description: types.StringValue(""),
+		Id:          types.StringUnknown(),
+	}
+}
+
+func zoneTestRecordWithId(hostname, rr, value, id string) zoneRecord {
+	r := zoneTestRecord(hostname, rr, value)
+	r.Id = types.StringValue(id)
+	return r
+}
+
+func zoneRecordIds(records []zoneRecord) []string {
+	var ids []string
+	for _, r := range records {
+		ids = append(ids, r.Id.ValueString())
+	}
+	return ids
+}
+
+func zoneRecordKeys(records []zoneRecord) []string {
+	var keys []string
+	for _, r := range records {
+		keys = append(keys, zoneRecordKey(r))
+	}
+	return keys
+}
+
+func TestValidateZoneRecords(t *testing.T) {
+	mx := zoneTestRecord("@", "MX", "mail.example.com")
+	mx.MXPriority = types.Int64Value(10)
+
+	tests := []struct {
+		name     string
+		records  []zoneRecord
+		problems int
+	}{
+		{
+			name: "valid",
+			records: []zoneRecord{
+				zoneTestRecord("www", "A", "192.0.2.10"),
+				zoneTestRecord("www", "AAAA", "2001:db8::10"),
+				zoneTestRecord("@", "TXT", "v=spf1 mx -all"),
+				mx,
+			},
+		},
+		{
+			name: "duplicate_record",
+			records: []zoneRecord{
+				zoneTestRecord("www", "A", "192.0.2.10"),
+				zoneTestRecord("WWW", "A", "192.0.2.10"),
+			},
+			problems: 1,
+		},
+		{
+			name: "same_hostname_different_value",
+			records: []zoneRecord{
+				zoneTestRecord("www", "A", "192.0.2.10"),
+				zoneTestRecord("www", "A", "192.0.2.11"),
+			},
+		},
+		{
+			name:     "a_with_ipv6_address",
+			records:  []zoneRecord{zoneTestRecord("www", "A", "2001:db8::10")},
+			problems: 1,
+		},
+		{
+			name:     "aaaa_with_ipv4_address",
+			records:  []zoneRecord{zoneTestRecord("www", "AAAA", "192.0.2.10")},
+			problems: 1,
+		},
+		{
+			name:     "a_with_hostname",
+			records:  []zoneRecord{zoneTestRecord("www", "A", "host.example.com")},
+			problems: 1,
+		},
+		{
+			name:     "mx_without_priority",
+			records:  []zoneRecord{zoneTestRecord("@", "MX", "mail.example.com")},
+			problems: 1,
+		},
+		{
+			name: "priority_on_non_mx",
+			records: []zoneRecord{
+				func() zoneRecord {
+					r := zoneTestRecord("www", "A", "192.0.2.10")
+					r.MXPriority = types.Int64Value(10)
+					return r
+				}(),
+			},
+			problems: 1,
+		},
+		{
+			name: "unknown_values_are_skipped",
+			records: []zoneRecord{
+				func() zoneRecord {
+					r := zoneTestRecord("www", "A", "")
+					r.Value = types.StringUnknown()
+					return r
+				}(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, validateZoneRecords(tt.records), tt.problems)
+		})
+	}
+}
+
+func TestPlanZoneChanges(t *testing.T) {
+	renamed := zoneTestRecord("www", "A", "192.0.2.10")
+	renamed.Description = types.StringValue("web server")
+
+	tests := []struct {
+		name     string
+		planned  []zoneRecord
+		existing []zoneRecord
+		add      []string
+		update   []string
+		remove   []string
+	}{
+		{
+			name:    "create",
+			planned: []zoneRecord{zoneTestRecord("www", "A", "192.0.2.10")},
+			add:     []string{"www|A|192.0.2.10"},
+		},
+		{
+			name:     "unchanged",
+			planned:  []zoneRecord{zoneTestRecord("www", "A", "192.0.2.10")},
+			existing: []zoneRecord{zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-1")},
+		},
+		{
+			name:     "hostname_case_is_ignored",
+			planned:  []zoneRecord{zoneTestRecord("WWW", "A", "192.0.2.10")},
+			existing: []zoneRecord{zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-1")},
+		},
+		{
+			name:     "update_description",
+			planned:  []zoneRecord{renamed},
+			existing: []zoneRecord{zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-1")},
+			update:   []string{"uuid-1"},
+		},
+		{
+			name:     "value_change_replaces_the_record",
+			planned:  []zoneRecord{zoneTestRecord("www", "A", "192.0.2.11")},
+			existing: []zoneRecord{zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-1")},
+			add:      []string{"www|A|192.0.2.11"},
+			remove:   []string{"uuid-1"},
+		},
+		{
+			name:    "remove_all",
+			planned: []zoneRecord{},
+			existing: []zoneRecord{
+				zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-1"),
+				zoneTestRecordWithId("mail", "A", "192.0.2.20", "uuid-2"),
+			},
+			remove: []string{"uuid-1", "uuid-2"},
+		},
+		{
+			name:    "duplicate_existing_records",
+			planned: []zoneRecord{zoneTestRecord("www", "A", "192.0.2.10")},
+			existing: []zoneRecord{
+				zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-1"),
+				zoneTestRecordWithId("www", "A", "192.0.2.10", "uuid-2"),
+			},
+			remove: []string{"uuid-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := planZoneChanges(tt.planned, tt.existing)
+
+			assert.Equal(t, tt.add, zoneRecordKeys(changes.add))
+			assert.Equal(t, tt.update, zoneRecordIds(changes.update))
+			assert.Equal(t, tt.remove, zoneRecordIds(changes.remove))
+			assert.Equal(t, tt.add == nil && tt.update == nil && tt.remove == nil, changes.empty())
+		})
+	}
+}
+
+func TestOrderZoneRecords(t *testing.T) {
+	tests := []struct {
+		name      string
+		records   []zoneRecord
+		reference []zoneRecord
+		want      []string
+	}{
+		{
+			name: "reference_order",
+			records: []zoneRecord{
+				zoneTestRecord("mail", "A", "192.0.2.20"),
+				zoneTestRecord("www", "A", "192.0.2.10"),
+			},
+			reference: []zoneRecord{
+				zoneTestRecord("www", "A", "192.0.2.10"),
+				zoneTestRecord("mail", "A", "192.0.2.20"),
+			},
+			want: []string{"www|A|192.0.2.10", "mail|A|192.0.2.20"},
+		},
+		{
+			name: "unreferenced_records_are_sorted_last",
+			records: []zoneRecord{
+				zoneTestRecord("www", "AAAA", "2001:db8::10"),
+				zoneTestRecord("mail", "A", "192.0.2.20"),
+				zoneTestRecord("www", "A", "192.0.2.10"),
+			},
+			reference: []zoneRecord{
+				zoneTestRecord("www", "A", "192.0.2.10"),
+			},
+			want: []string{"www|A|192.0.2.10", "mail|A|192.0.2.20", "www|AAAA|2001:db8::10"},
+		},
+		{
+			name: "no_reference",
+			records: []zoneRecord{
+				zoneTestRecord("www", "A", "192.0.2.10"),
+				zoneTestRecord("@", "TXT", "v=spf1 -all"),
+			},
+			want: []string{"@|TXT|v=spf1 -all", "www|A|192.0.2.10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := append([]zoneRecord{}, tt.records...)
+
+			assert.Equal(t, tt.want, zoneRecordKeys(orderZoneRecords(tt.records, tt.reference)))
+			assert.Equal(t, records, tt.records)
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package unbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/opnsense/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &zoneResource{}
+var _ resource.ResourceWithConfigure = &zoneResource{}
+var _ resource.ResourceWithValidateConfig = &zoneResource{}
+
+func newZoneResource() resource.Resource {
+	return &zoneResource{}
+}
+
+// zoneResource defines the resource implementation.
+type zoneResource struct {
+	client opnsense.Client
+}
+
+func (r *zoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_zone"
+}
+
+func (r *zoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = zoneResourceSchema()
+}
+
+func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *zoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *zoneResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Records.IsNull() || data.Records.IsUnknown() {
+		return
+	}
+
+	records, err := zoneRecordsFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	for _, problem := range validateZoneRecords(records) {
+		resp.Diagnostics.AddError("Invalid Zone Record", problem)
+	}
+}
+
+// reconcile applies the planned records to the host overrides of the domain and
+// reconfigures Unbound once if anything changed. The returned list contains every
+// record known to exist in OPNsense, even when an error occurs part way through.
+func (r *zoneResource) reconcile(ctx context.Context, plan *zoneResourceModel) (types.List, error) {
+	domain := plan.Domain.ValueString()
+
+	planned, err := zoneRecordsFromModel(ctx, plan)
+	if err != nil {
+		return types.ListNull(types.ObjectType{AttrTypes: zoneRecordAttrTypes}), err
+	}
+
+	existing, err := fetchZoneRecords(ctx, r.client.Unbound(), domain)
+	if err != nil {
+		return types.ListNull(types.ObjectType{AttrTypes: zoneRecordAttrTypes}), err
+	}
+
+	// Track which records exist in OPNsense, keyed by record key
+	applied := map[string]zoneRecord{}
+	for _, e := range existing {
+		if _, ok := applied[zoneRecordKey(e)]; !ok {
+			applied[zoneRecordKey(e)] = e
+		}
+	}
+
+	changes := planZoneChanges(planned, existing)
+	changed, err := r.applyChanges(ctx, domain, changes, applied)
+
+	if changed {
+		reconfigureErr := r.reconfigure(ctx)
+		if err == nil {
+			err = reconfigureErr
+		}
+	}
+
+	// Build the resulting records in plan order, followed by any records that could not be removed
+	var result []zoneRecord
+	for _, p := range planned {
+		if a, ok := applied[zoneRecordKey(p)]; ok {
+			result = append(result, a)
+			delete(applied, zoneRecordKey(p))
+		}
+	}
+	for _, e := range existing {
+		if a, ok := applied[zoneRecordKey(e)]; ok && a.Id.Equal(e.Id) {
+			result = append(result, a)
+		}
+	}
+
+	list, convErr := zoneRecordsToList(ctx, result)
+	if convErr != nil {
+		return list, convErr
+	}
+
+	return list, err
+}
+
+// applyChanges makes the host override calls, without reconfiguring Unbound. It reports
+// whether any call succeeded.
+func (r *zoneResource) applyChanges(ctx context.Context, domain string, changes zoneChanges, applied map[string]zoneRecord) (bool, error) {
+	changed := false
+
+	for _, e := range changes.remove {
+		res, err := r.client.Unbound().UnboundDelHostOverride(ctx, e.Id.ValueString())
+		if err != nil {
+			var notFoundError *errs.NotFoundError
+			if !errors.As(err, &notFoundError) {
+				return changed, fmt.Errorf("unable to delete %s record %q: %w", e.Type.ValueString(), e.Hostname.ValueString(), err)
+			}
+		}
+		if res != nil && res.Result == "failed" {
+			return changed, errors.New(formatActionResultFailure("delete host override", res))
+		}
+
+		changed = true
+		if a, ok := applied[zoneRecordKey(e)]; ok && a.Id.Equal(e.Id) {
+			delete(applied, zoneRecordKey(e))
+		}
+		tflog.Debug(ctx, "removed zone record", map[string]any{"id": e.Id.ValueString()})
+	}
+
+	for _, p := range changes.update {
+		res, err := r.client.Unbound().UnboundSetHostOverride(ctx, p.Id.ValueString(), p.toHostOverride(domain))
+		if err != nil {
+			return changed, fmt.Errorf("unable to update %s record %q: %w", p.Type.ValueString(), p.Hostname.ValueString(), err)
+		}
+		if res != nil && res.Result == "failed" {
+			return changed, errors.New(formatActionResultFailure("update host override", res))
+		}
+
+		changed = true
+		applied[zoneRecordKey(p)] = p
+		tflog.Debug(ctx, "updated zone record", map[string]any{"id": p.Id.ValueString()})
+	}
+
+	for _, p := range changes.add {
+		res, err := r.client.Unbound().UnboundAddHostOverride(ctx, p.toHostOverride(domain))
+		if err != nil {
+			return changed, fmt.Errorf("unable to add %s record %q: %w", p.Type.ValueString(), p.Hostname.ValueString(), err)
+		}
+		if res == nil || res.Result == "failed" || res.UUID == "" {
+			return changed, errors.New(formatActionResultFailure("add host override", res))
+		}
+
+		changed = true
+		p.Id = types.StringValue(res.UUID)
+		applied[zoneRecordKey(p)] = p
+		tflog.Debug(ctx, "added zone record", map[string]any{"id": res.UUID})
+	}
+
+	return changed, nil
+}
+
+func (r *zoneResource) reconfigure(ctx context.Context) error {
+	res, err := r.client.Unbound().UnboundReconfigure(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to reconfigure unbound: %w", err)
+	}
+	if res != nil && res.Result == "failed" {
+		return errors.New(formatActionResultFailure("reconfigure unbound", res))
+	}
+	return nil
+}
+
+func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *zoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the records with the host overrides of the domain
+	records, err := r.reconcile(ctx, data)
+	data.Records = records
+
+	// Save the records that were created, so they are cleaned up on destroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create unbound zone, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+}
+
+func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *zoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, err := zoneRecordsFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound zone, got error: %s", err))
+		return
+	}
+
+	// Get all host overrides of the domain from OPNsense unbound API
+	records, err := fetchZoneRecords(ctx, r.client.Unbound(), data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound zone, got error: %s", err))
+		return
+	}
+
+	data.Records, err = zoneRecordsToList(ctx, orderZoneRecords(records, prior))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read unbound zone, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *zoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply only the records that differ from OPNsense
+	records, err := r.reconcile(ctx, data)
+	data.Records = records
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update unbound zone, got error: %s", err))
+		return
+	}
+}
+
+func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *zoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := zoneRecordsFromModel(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete unbound zone, got error: %s", err))
+		return
+	}
+
+	// Remove every record, then reconfigure once
+	changed, err := r.applyChanges(ctx, data.Domain.ValueString(), zoneChanges{remove: records}, map[string]zoneRecord{})
+	if changed {
+		reconfigureErr := r.reconfigure(ctx)
+		if err == nil {
+			err = reconfigureErr
+		}
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete unbound zone, got error: %s", err))
+		return
+	}
+}
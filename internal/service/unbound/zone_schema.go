@@ -0,0 +1,118 @@
+package unbound
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zoneResourceModel describes the resource data model.
+type zoneResourceModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	Records types.List   `tfsdk:"records"`
+}
+
+// zoneRecord describes a single record of a zone, stored as a host override.
+type zoneRecord struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Type        types.String `tfsdk:"type"`
+	Value       types.String `tfsdk:"value"`
+	MXPriority  types.Int64  `tfsdk:"mx_priority"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var zoneRecordAttrTypes = map[string]attr.Type{
+	"enabled":     types.BoolType,
+	"hostname":    types.StringType,
+	"type":        types.StringType,
+	"value":       types.StringType,
+	"mx_priority": types.Int64Type,
+	"description": types.StringType,
+	"id":          types.StringType,
+}
+
+func zoneResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manage all host overrides of a domain as a single zone. Records are reconciled against the existing host overrides of the domain in one pass, and Unbound is reconfigured once per apply. Host overrides of the domain that are not listed are removed, so do not combine with `opnsense_unbound_host_override` resources for the same domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the zone, e.g. example.com. Changing this recreates the zone.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "The records of the zone. Each record is identified by its `hostname`, `type` and `value`, so this combination must be unique.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Enable this record. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Name of the host, without the domain part. Use `*` to create a wildcard entry, or `\"\"` for the domain itself.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`. Defaults to `A`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("A"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("A", "AAAA", "MX", "TXT"),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the record: the IP address for `A` and `AAAA`, the mail host for `MX`, or the text for `TXT`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"mx_priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority of MX record, e.g. 10. Must be set when `type` is `MX`. Defaults to `-1`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(-1),
+							Validators: []validator.Int64{
+								int64validator.Any(
+									int64validator.OneOf(-1),
+									int64validator.Between(0, 65535),
+								),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the host override backing this record.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
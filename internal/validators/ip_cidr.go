@@ -4,7 +4,7 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"regexp"
+	"net"
 )
 
 type ipOrCIDRValidator struct{}
@@ -22,7 +22,8 @@ func (validator ipOrCIDRValidator) ValidateString(ctx context.Context, request v
 		return
 	}
 
-	if _, err := regexp.Compile(`^(([0-9]{1,3}\.){3}[0-9]{1,3}(\/([0-9]|[1-2][0-9]|3[0-2]))?|([0-9a-fA-F:]+)(\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))?)$`); err != nil {
+	value := request.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(value); err != nil && net.ParseIP(value) == nil {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -51,7 +52,7 @@ func (validator cidrValidator) ValidateString(ctx context.Context, request valid
 		return
 	}
 
-	if _, err := regexp.Compile(`^(([0-9]{1,3}\.){3}[0-9]{1,3}\/(3[0-2]|[1-2]?[0-9]))$|^(([0-9a-fA-F:]+)\/(12[0-8]|1[0-1][0-9]|[1-9]?[0-9]))$`); err != nil {
+	if _, _, err := net.ParseCIDR(request.ConfigValue.ValueString()); err != nil {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -64,3 +65,32 @@ func (validator cidrValidator) ValidateString(ctx context.Context, request valid
 func CIDR() validator.String {
 	return cidrValidator{}
 }
+
+type ipValidator struct{}
+
+func (validator ipValidator) Description(_ context.Context) string {
+	return "must be a valid IPv4 or IPv6 address (e.g. 192.168.0.1, 2001:db8::1)"
+}
+
+func (validator ipValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator ipValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(request.ConfigValue.ValueString()) == nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+func IP() validator.String {
+	return ipValidator{}
+}
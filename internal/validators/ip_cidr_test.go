@@ -0,0 +1,69 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func validateString(v validator.String, value types.String) bool {
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: value,
+	}, resp)
+	return !resp.Diagnostics.HasError()
+}
+
+func TestCIDR(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.String
+		valid bool
+	}{
+		{name: "ipv4_network", input: types.StringValue("192.168.0.0/24"), valid: true},
+		{name: "ipv4_host_prefix", input: types.StringValue("192.168.0.1/24"), valid: true},
+		{name: "ipv6_network", input: types.StringValue("2001:db8::/64"), valid: true},
+		{name: "null", input: types.StringNull(), valid: true},
+		{name: "unknown", input: types.StringUnknown(), valid: true},
+		{name: "missing_prefix", input: types.StringValue("192.168.0.0"), valid: false},
+		{name: "prefix_too_long", input: types.StringValue("192.168.0.0/33"), valid: false},
+		{name: "octet_out_of_range", input: types.StringValue("192.168.0.256/24"), valid: false},
+		{name: "empty", input: types.StringValue(""), valid: false},
+		{name: "garbage", input: types.StringValue("lan"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateString(CIDR(), tt.input))
+		})
+	}
+}
+
+func TestIpOrCIDR(t *testing.T) {
+	tests := []struct {
+		name  string
+		input types.String
+		valid bool
+	}{
+		{name: "ipv4_address", input: types.StringValue("192.168.0.1"), valid: true},
+		{name: "ipv4_network", input: types.StringValue("192.168.0.0/24"), valid: true},
+		{name: "ipv6_address", input: types.StringValue("2001:db8::1"), valid: true},
+		{name: "ipv6_network", input: types.StringValue("2001:db8::/64"), valid: true},
+		{name: "null", input: types.StringNull(), valid: true},
+		{name: "ipv6_prefix_too_long", input: types.StringValue("2001:db8::/129"), valid: false},
+		{name: "octet_out_of_range", input: types.StringValue("300.1.1.1"), valid: false},
+		{name: "empty", input: types.StringValue(""), valid: false},
+		{name: "hostname", input: types.StringValue("gateway.example.com"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, validateString(IpOrCIDR(), tt.input))
+		})
+	}
+}